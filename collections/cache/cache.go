@@ -0,0 +1,27 @@
+// Package cache provides generic fixed capacity caches with O(1) get, put and eviction:
+// [LRU] (least recently used) and [LFU] (least frequently used).
+// Both pair a map with [list.DoublyLinkedList] handles, and neither is safe for concurrent use.
+package cache
+
+// Stats holds the hit, miss and eviction counts of a cache.
+type Stats struct {
+	Hits      uint64 // number of Get calls that found the key
+	Misses    uint64 // number of Get calls that did not find the key
+	Evictions uint64 // number of entries evicted to make room for new ones
+}
+
+// HitRatio returns the fraction of Get calls that found the key, or 0 if Get was never called.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// checkCapacity panics if the capacity of a cache is not positive.
+func checkCapacity(capacity int) {
+	if capacity <= 0 {
+		panic("cache: capacity must be greater than 0")
+	}
+}
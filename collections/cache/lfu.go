@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/hegdevenky/go_commons/collections/list"
+)
+
+// lfuEntry is a cached entry of an LFU, it knows its frequency bucket and its position in the bucket.
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket list.Handle[*lfuBucket[K, V]]
+	handle list.Handle[*lfuEntry[K, V]]
+}
+
+// lfuBucket holds the entries of an LFU that were used freq times.
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries list.DoublyLinkedList[*lfuEntry[K, V]] // most recently used first
+}
+
+// LFU is a fixed capacity cache that evicts the least frequently used entry when a new key is put into a full cache.
+// Among the entries used the same number of times, the least recently used one is evicted.
+// Get and Put count as a use of the key.
+//
+// Entries are grouped into buckets of the same use count. Buckets are kept in a [list.DoublyLinkedList]
+// in ascending order of use count, and each bucket keeps its entries in a DoublyLinkedList
+// from the most to the least recently used, so every operation is O(1).
+type LFU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*lfuEntry[K, V]
+	buckets  list.DoublyLinkedList[*lfuBucket[K, V]] // ascending use count
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewLFU returns an empty [LFU] cache holding at most capacity entries.
+// onEvict, if not nil, is called with every entry evicted to make room for a new key;
+// it is not called for entries removed with [LFU.Remove] or replaced by [LFU.Put].
+// NewLFU panics if capacity is not greater than 0.
+func NewLFU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LFU[K, V] {
+	checkCapacity(capacity)
+	return &LFU[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*lfuEntry[K, V], capacity),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value cached for the key and true, and counts a use of the key.
+// It returns the zero value of V and false if the key is not in the cache.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	entry, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.use(entry)
+	return entry.value, true
+}
+
+// Peek returns the value cached for the key and true, without counting a use or changing the statistics.
+// It returns the zero value of V and false if the key is not in the cache.
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	entry, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Contains returns true if the key is in the cache, without counting a use or changing the statistics.
+func (c *LFU[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Frequency returns the number of times the key was used since it was put into the cache,
+// or 0 if the key is not in the cache.
+func (c *LFU[K, V]) Frequency(key K) int {
+	entry, ok := c.items[key]
+	if !ok {
+		return 0
+	}
+	return entry.bucket.Value().freq
+}
+
+// Put caches the value for the key and counts a use of the key.
+// If the key is new and the cache is full, the least frequently used entry is evicted first.
+// It returns true if an entry was evicted.
+func (c *LFU[K, V]) Put(key K, value V) (evicted bool) {
+	if entry, ok := c.items[key]; ok {
+		entry.value = value
+		c.use(entry)
+		return false
+	}
+	if len(c.items) == c.capacity {
+		c.evict()
+		evicted = true
+	}
+	first, err := c.buckets.FirstHandle()
+	if err != nil || first.Value().freq != 1 {
+		first = c.buckets.AddFirstHandle(&lfuBucket[K, V]{freq: 1})
+	}
+	entry := &lfuEntry[K, V]{key: key, value: value, bucket: first}
+	entry.handle = first.Value().entries.AddFirstHandle(entry)
+	c.items[key] = entry
+	return evicted
+}
+
+// Remove removes the key from the cache and returns its value and true.
+// It returns the zero value of V and false if the key is not in the cache.
+func (c *LFU[K, V]) Remove(key K) (V, bool) {
+	entry, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	delete(c.items, key)
+	c.unlink(entry)
+	return entry.value, true
+}
+
+// Len returns the number of entries in the cache.
+func (c *LFU[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of entries of the cache.
+func (c *LFU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction counts of the cache.
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// All returns an iterator over the entries of the cache, from the most to the least frequently used.
+// Entries used the same number of times are ordered from the most to the least recently used.
+// Iterating does not count as a use of the entries. The cache must not be modified during iteration.
+func (c *LFU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, bucket := range c.buckets.ReverseAll() {
+			for entry := range bucket.entries.Values() {
+				if !yield(entry.key, entry.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// use moves the entry to the bucket of the next use count, as the most recently used entry of that bucket.
+func (c *LFU[K, V]) use(entry *lfuEntry[K, V]) {
+	freq := entry.bucket.Value().freq
+	next, ok := entry.bucket.Next()
+	if !ok || next.Value().freq != freq+1 {
+		next, _ = c.buckets.InsertAfter(entry.bucket, &lfuBucket[K, V]{freq: freq + 1})
+	}
+	c.unlink(entry)
+	entry.bucket = next
+	entry.handle = next.Value().entries.AddFirstHandle(entry)
+}
+
+// unlink removes the entry from its bucket, and the bucket from the cache if it is left empty.
+func (c *LFU[K, V]) unlink(entry *lfuEntry[K, V]) {
+	bucket := entry.bucket.Value()
+	bucket.entries.Remove(entry.handle)
+	if bucket.entries.IsEmpty() {
+		c.buckets.Remove(entry.bucket)
+	}
+}
+
+// evict removes the least recently used entry of the least frequently used bucket
+// and calls the eviction callback with it.
+func (c *LFU[K, V]) evict() {
+	first, _ := c.buckets.FirstHandle()
+	victim, _ := first.Value().entries.GetLast()
+	delete(c.items, victim.key)
+	c.unlink(victim)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(victim.key, victim.value)
+	}
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLFU_Eviction(t *testing.T) {
+	var evicted []string
+	c := NewLFU(3, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	// frequencies: a=3, b=2, c=1
+	if !c.Put("d", 4) {
+		t.Errorf("Put(d) = false, want true")
+	}
+	// frequencies: a=3, b=2, d=1, c is evicted; d is now the least frequently used
+	c.Put("e", 5)
+	if !reflect.DeepEqual(evicted, []string{"c", "d"}) {
+		t.Errorf("evicted = %v, want [c d]", evicted)
+	}
+	if got := keys(c.All()); !reflect.DeepEqual(got, []string{"a", "b", "e"}) {
+		t.Errorf("All() = %v, want [a b e]", got)
+	}
+	for key, want := range map[string]int{"a": 3, "b": 2, "e": 1, "c": 0} {
+		if got := c.Frequency(key); got != want {
+			t.Errorf("Frequency(%v) = %v, want %v", key, got, want)
+		}
+	}
+	want := Stats{Hits: 3, Evictions: 2}
+	if c.Stats() != want {
+		t.Errorf("Stats() = %+v, want %+v", c.Stats(), want)
+	}
+}
+
+func TestLFU_TieBreaksByRecency(t *testing.T) {
+	c := NewLFU[int, int](3, nil)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(1)
+	c.Get(2)
+	c.Get(3)
+	// all used twice, 1 is the least recently used
+	c.Put(4, 4)
+	if c.Contains(1) {
+		t.Errorf("Contains(1) = true, want false")
+	}
+	if got := keys(c.All()); !reflect.DeepEqual(got, []int{3, 2, 4}) {
+		t.Errorf("All() = %v, want [3 2 4]", got)
+	}
+}
+
+func TestLFU_PutExistingPeekRemove(t *testing.T) {
+	c := NewLFU[string, int](2, nil)
+	c.Put("a", 1)
+	if c.Put("a", 2) {
+		t.Errorf("Put(a) = true, want false for an existing key")
+	}
+	if v, ok := c.Peek("a"); !ok || v != 2 || c.Frequency("a") != 2 {
+		t.Errorf("Peek(a) = %v, %v, Frequency(a) = %v, want 2, true, 2", v, ok, c.Frequency("a"))
+	}
+	if _, ok := c.Get("missing"); ok {
+		t.Errorf("Get(missing) ok = true, want false")
+	}
+	if v, ok := c.Remove("a"); !ok || v != 2 || c.Len() != 0 {
+		t.Errorf("Remove(a) = %v, %v, Len() = %v, want 2, true, 0", v, ok, c.Len())
+	}
+	if _, ok := c.Remove("a"); ok {
+		t.Errorf("Remove(a) ok = true, want false")
+	}
+	if _, ok := c.Peek("a"); ok {
+		t.Errorf("Peek(a) ok = true, want false")
+	}
+	// the cache is usable again after the last bucket was removed
+	c.Put("b", 1)
+	c.Put("c", 1)
+	c.Get("c")
+	c.Put("d", 1)
+	if got := keys(c.All()); !reflect.DeepEqual(got, []string{"c", "d"}) {
+		t.Errorf("All() = %v, want [c d]", got)
+	}
+	if c.Capacity() != 2 || c.Stats().Misses != 1 {
+		t.Errorf("Capacity(), Misses = %v, %v, want 2, 1", c.Capacity(), c.Stats().Misses)
+	}
+}
+
+func TestLFU_AllBreak(t *testing.T) {
+	c := NewLFU[int, int](3, nil)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Get(1)
+	for k := range c.All() {
+		if k != 1 {
+			t.Errorf("All() first key = %v, want 1", k)
+		}
+		break
+	}
+}
+
+func TestNewLFU_InvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewLFU(-1) did not panic")
+		}
+	}()
+	NewLFU[int, int](-1, nil)
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/hegdevenky/go_commons/collections/list"
+)
+
+// lruEntry is the element of the recency list of an LRU.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRU is a fixed capacity cache that evicts the least recently used entry when a new key is put into a full cache.
+// Get and Put mark the key as the most recently used.
+//
+// Entries are kept in a [list.DoublyLinkedList] ordered from the most to the least recently used,
+// and a map from the keys to their [list.Handle], so every operation is O(1).
+type LRU[K comparable, V any] struct {
+	capacity int
+	items    map[K]list.Handle[lruEntry[K, V]]
+	recency  list.DoublyLinkedList[lruEntry[K, V]] // most recently used first
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewLRU returns an empty [LRU] cache holding at most capacity entries.
+// onEvict, if not nil, is called with every entry evicted to make room for a new key;
+// it is not called for entries removed with [LRU.Remove] or replaced by [LRU.Put].
+// NewLRU panics if capacity is not greater than 0.
+func NewLRU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LRU[K, V] {
+	checkCapacity(capacity)
+	return &LRU[K, V]{
+		capacity: capacity,
+		items:    make(map[K]list.Handle[lruEntry[K, V]], capacity),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value cached for the key and true, and marks the key as the most recently used.
+// It returns the zero value of V and false if the key is not in the cache.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	h, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.recency.MoveToFront(h)
+	return h.Value().value, true
+}
+
+// Peek returns the value cached for the key and true, without changing its recency or the statistics.
+// It returns the zero value of V and false if the key is not in the cache.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	h, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return h.Value().value, true
+}
+
+// Contains returns true if the key is in the cache, without changing its recency or the statistics.
+func (c *LRU[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put caches the value for the key and marks the key as the most recently used.
+// If the key is new and the cache is full, the least recently used entry is evicted first.
+// It returns true if an entry was evicted.
+func (c *LRU[K, V]) Put(key K, value V) (evicted bool) {
+	if h, ok := c.items[key]; ok {
+		c.recency.Update(h, lruEntry[K, V]{key, value})
+		c.recency.MoveToFront(h)
+		return false
+	}
+	if len(c.items) == c.capacity {
+		c.evict()
+		evicted = true
+	}
+	c.items[key] = c.recency.AddFirstHandle(lruEntry[K, V]{key, value})
+	return evicted
+}
+
+// Remove removes the key from the cache and returns its value and true.
+// It returns the zero value of V and false if the key is not in the cache.
+func (c *LRU[K, V]) Remove(key K) (V, bool) {
+	h, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	delete(c.items, key)
+	entry, _ := c.recency.Remove(h)
+	return entry.value, true
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRU[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of entries of the cache.
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction counts of the cache.
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// All returns an iterator over the entries of the cache, from the most to the least recently used.
+// Iterating does not change the recency of the entries. The cache must not be modified during iteration.
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := range c.recency.Values() {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// evict removes the least recently used entry and calls the eviction callback with it.
+func (c *LRU[K, V]) evict() {
+	entry, _ := c.recency.RemoveLast()
+	delete(c.items, entry.key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(entry.key, entry.value)
+	}
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+)
+
+// keys returns the keys of the cache in the order of its All iterator.
+func keys[K comparable, V any](all func(yield func(K, V) bool)) []K {
+	var ks []K
+	for k := range all {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+func TestLRU_GetPut(t *testing.T) {
+	var evicted []string
+	c := NewLRU(2, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	if c.Put("a", 1) || c.Put("b", 2) {
+		t.Fatalf("Put() = true, want false before the cache is full")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(a) = %v, %v, want 1, true", v, ok)
+	}
+	// b is now the least recently used
+	if !c.Put("c", 3) {
+		t.Errorf("Put(c) = false, want true")
+	}
+	if c.Contains("b") || !c.Contains("a") || !c.Contains("c") {
+		t.Errorf("keys = %v, want [c a]", keys(c.All()))
+	}
+	if !reflect.DeepEqual(evicted, []string{"b"}) {
+		t.Errorf("evicted = %v, want [b]", evicted)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Get(b) ok = true, want false")
+	}
+	want := Stats{Hits: 1, Misses: 1, Evictions: 1}
+	if c.Stats() != want {
+		t.Errorf("Stats() = %+v, want %+v", c.Stats(), want)
+	}
+	if got := c.Stats().HitRatio(); got != 0.5 {
+		t.Errorf("HitRatio() = %v, want 0.5", got)
+	}
+}
+
+func TestLRU_PutExisting(t *testing.T) {
+	evictions := 0
+	c := NewLRU(2, func(string, int) { evictions++ })
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if c.Put("a", 10) {
+		t.Errorf("Put(a) = true, want false for an existing key")
+	}
+	if v, _ := c.Peek("a"); v != 10 {
+		t.Errorf("Peek(a) = %v, want 10", v)
+	}
+	c.Put("c", 3)
+	if got := keys(c.All()); !reflect.DeepEqual(got, []string{"c", "a"}) {
+		t.Errorf("All() = %v, want [c a]", got)
+	}
+	if evictions != 1 || c.Len() != 2 || c.Capacity() != 2 {
+		t.Errorf("evictions, Len(), Capacity() = %v, %v, %v, want 1, 2, 2", evictions, c.Len(), c.Capacity())
+	}
+}
+
+func TestLRU_PeekRemove(t *testing.T) {
+	c := NewLRU[int, string](3, nil)
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Put(3, "three")
+	// Peek does not change the recency, so 1 stays the least recently used
+	if v, ok := c.Peek(1); !ok || v != "one" {
+		t.Errorf("Peek(1) = %v, %v, want one, true", v, ok)
+	}
+	if got := keys(c.All()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("All() = %v, want [3 2 1]", got)
+	}
+	if v, ok := c.Remove(2); !ok || v != "two" {
+		t.Errorf("Remove(2) = %v, %v, want two, true", v, ok)
+	}
+	if _, ok := c.Remove(2); ok {
+		t.Errorf("Remove(2) ok = true, want false")
+	}
+	if _, ok := c.Peek(2); ok {
+		t.Errorf("Peek(2) ok = true, want false")
+	}
+	if c.Put(4, "four") {
+		t.Errorf("Put(4) = true, want false after Remove")
+	}
+	if got := keys(c.All()); !reflect.DeepEqual(got, []int{4, 3, 1}) {
+		t.Errorf("All() = %v, want [4 3 1]", got)
+	}
+	if c.Stats() != (Stats{}) {
+		t.Errorf("Stats() = %+v, want zero", c.Stats())
+	}
+}
+
+func TestLRU_AllBreak(t *testing.T) {
+	c := NewLRU[int, int](5, nil)
+	for i := range 5 {
+		c.Put(i, i*i)
+	}
+	var got []int
+	for k, v := range c.All() {
+		if k == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{16, 9}) {
+		t.Errorf("All() values = %v, want [16 9]", got)
+	}
+}
+
+func TestNewLRU_InvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewLRU(0) did not panic")
+		}
+	}()
+	NewLRU[int, int](0, nil)
+}
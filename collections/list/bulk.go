@@ -0,0 +1,267 @@
+package list
+
+import "iter"
+
+// SinglyLinkedList bulk operations.
+
+// AddAll appends the elements of seq to the end of the list, in order.
+// seq must not iterate over this list. The method returns the same list to support method chaining.
+func (s *SinglyLinkedList[T]) AddAll(seq iter.Seq[T]) LinkedList[T] {
+	pred := s.tail
+	for v := range seq {
+		pred = s.linkAfter(v, pred)
+	}
+	return s
+}
+
+// InsertAll inserts the elements of seq at the given index, in order:
+// the first element of seq ends up at the index, and the element previously at the index follows the last one.
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list.
+// seq must not iterate over this list.
+func (s *SinglyLinkedList[T]) InsertAll(index int, seq iter.Seq[T]) (bool, error) {
+	if index < 0 || index > s.Len() {
+		return false, errIndexOutOfBounds(index, s.Len())
+	}
+	pred := s.nodeAt(index - 1)
+	for v := range seq {
+		pred = s.linkAfter(v, pred)
+	}
+	return true, nil
+}
+
+// Clear removes all the elements of the list.
+func (s *SinglyLinkedList[T]) Clear() {
+	for cur := s.head; cur != nil; {
+		// unlink every node so that nodes still referenced elsewhere do not retain the others
+		next := cur.next
+		cur.next = nil
+		cur = next
+	}
+	s.head, s.tail, s.len = nil, nil, 0
+	s.modCount++
+}
+
+// Clone returns a new [SinglyLinkedList] holding the same elements in the same order.
+// The elements themselves are copied by assignment.
+func (s *SinglyLinkedList[T]) Clone() *SinglyLinkedList[T] {
+	clone := &SinglyLinkedList[T]{}
+	clone.AddAll(s.Values())
+	return clone
+}
+
+// DoublyLinkedList bulk operations.
+
+// AddAll appends the elements of seq to the end of the list, in order.
+// seq must not iterate over this list. The method returns the same list to support method chaining.
+func (d *DoublyLinkedList[T]) AddAll(seq iter.Seq[T]) LinkedList[T] {
+	// no unshare, as in AddLast
+	for v := range seq {
+		d.linkBefore(v, nil)
+	}
+	return d
+}
+
+// InsertAll inserts the elements of seq at the given index, in order:
+// the first element of seq ends up at the index, and the element previously at the index follows the last one.
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list.
+// seq must not iterate over this list.
+func (d *DoublyLinkedList[T]) InsertAll(index int, seq iter.Seq[T]) (bool, error) {
+	if index < 0 || index > d.Len() {
+		return false, errIndexOutOfBounds(index, d.Len())
+	}
+	d.unshare()
+	succ := d.nodeAt(index)
+	for v := range seq {
+		d.linkBefore(v, succ)
+	}
+	return true, nil
+}
+
+// Clear removes all the elements of the list. The handles of the removed elements become invalid.
+func (d *DoublyLinkedList[T]) Clear() {
+	if d.shared {
+		// the nodes now only belong to the snapshots, they are left untouched and not given back to the pool
+		d.shared, d.owner = false, nil
+	} else {
+		for cur := d.head; cur != nil; {
+			next := cur.next
+			cur.next, cur.prev, cur.owner = nil, nil, nil
+			releaseNode(d.pool, cur)
+			cur = next
+		}
+	}
+	d.head, d.tail, d.len = nil, nil, 0
+	d.modCount++
+}
+
+// Clone returns a new [DoublyLinkedList] holding the same elements in the same order.
+// The elements themselves are copied by assignment, and the handles of this list are not valid in the clone.
+func (d *DoublyLinkedList[T]) Clone() *DoublyLinkedList[T] {
+	clone := &DoublyLinkedList[T]{}
+	clone.AddAll(d.Values())
+	return clone
+}
+
+// Splice moves all the elements of other into this list at the given index, in order, leaving other empty.
+// The nodes are relinked without copying, so apart from finding the position, Splice takes O(1) time.
+// The handles of the moved elements stay valid and now refer to this list.
+//
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list,
+// and an [ErrIllegalArgument] error if other is this list; in both cases neither list is modified.
+// Ex:
+//
+//	a := NewLinkedListFrom(DoublyLinked, 1, 4).(*DoublyLinkedList[int])
+//	b := NewLinkedListFrom(DoublyLinked, 2, 3).(*DoublyLinkedList[int])
+//	a.Splice(1, b) // a: 1 <=> 2 <=> 3 <=> 4, b is empty
+func (d *DoublyLinkedList[T]) Splice(index int, other *DoublyLinkedList[T]) error {
+	switch {
+	case other == d:
+		return errSpliceIntoItself()
+	case index < 0 || index > d.Len():
+		return errIndexOutOfBounds(index, d.Len())
+	case other == nil || other.IsEmpty():
+		return nil
+	}
+	d.unshare()
+	other.unshare()
+	first, last, n := other.head, other.tail, other.len
+	other.moveNodesTo(d.identity())
+
+	succ := d.nodeAt(index)
+	pred := d.tail
+	if succ != nil {
+		pred = succ.prev
+	}
+	first.prev, last.next = pred, succ
+	if pred == nil {
+		d.head = first
+	} else {
+		pred.next = first
+	}
+	if succ == nil {
+		d.tail = last
+	} else {
+		succ.prev = last
+	}
+	d.len += n
+	d.modCount++
+	return nil
+}
+
+// Concat moves all the elements of other to the end of this list, in order, leaving other empty.
+// It is equivalent to d.Splice(d.Len(), other), and takes O(1) time.
+func (d *DoublyLinkedList[T]) Concat(other *DoublyLinkedList[T]) error {
+	return d.Splice(d.Len(), other)
+}
+
+// moveNodesTo empties the list without unlinking its nodes, which are being moved to the list owning owner.
+func (d *DoublyLinkedList[T]) moveNodesTo(owner *nodeOwner) {
+	// the moved nodes now belong to the destination, and this list gets a new owner for the nodes added later
+	d.owner.forward, d.owner = owner, nil
+	d.head, d.tail, d.len = nil, nil, 0
+	d.modCount++
+}
+
+// CircularLinkedList bulk operations.
+
+// AddAll appends the elements of seq to the end (before the head) of the list, in order.
+// seq must not iterate over this list. The method returns the same list to support method chaining.
+func (c *CircularLinkedList[T]) AddAll(seq iter.Seq[T]) LinkedList[T] {
+	for v := range seq {
+		c.linkBefore(v, nil)
+	}
+	return c
+}
+
+// InsertAll inserts the elements of seq at the given index, in order:
+// the first element of seq ends up at the index, and the element previously at the index follows the last one.
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list.
+// seq must not iterate over this list.
+func (c *CircularLinkedList[T]) InsertAll(index int, seq iter.Seq[T]) (bool, error) {
+	if index < 0 || index > c.Len() {
+		return false, errIndexOutOfBounds(index, c.Len())
+	}
+	// succ stays the node at which the elements are inserted, only the first inserted element can become the head
+	succ := c.nodeAt(index)
+	for v := range seq {
+		c.linkBefore(v, succ)
+	}
+	return true, nil
+}
+
+// Clear removes all the elements of the list. The handles of the removed elements become invalid.
+func (c *CircularLinkedList[T]) Clear() {
+	for i, cur := 0, c.head; i < c.len; i++ {
+		next := cur.next
+		cur.next, cur.prev, cur.owner = nil, nil, nil
+		releaseNode(c.pool, cur)
+		cur = next
+	}
+	c.head, c.tail, c.len = nil, nil, 0
+	c.modCount++
+}
+
+// Clone returns a new [CircularLinkedList] holding the same elements in the same order.
+// The elements themselves are copied by assignment, and the handles of this list are not valid in the clone.
+func (c *CircularLinkedList[T]) Clone() *CircularLinkedList[T] {
+	clone := &CircularLinkedList[T]{}
+	clone.AddAll(c.Values())
+	return clone
+}
+
+// Splice moves all the elements of other into this list at the given index, in order, leaving other empty.
+// Splicing at index 0 makes the first element of other the head, and at index c.Len() makes its last element the tail.
+// The nodes are relinked without copying, so apart from finding the position, Splice takes O(1) time.
+// The handles of the moved elements stay valid and now refer to this list.
+//
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list,
+// and an [ErrIllegalArgument] error if other is this list; in both cases neither list is modified.
+func (c *CircularLinkedList[T]) Splice(index int, other *CircularLinkedList[T]) error {
+	switch {
+	case other == c:
+		return errSpliceIntoItself()
+	case index < 0 || index > c.Len():
+		return errIndexOutOfBounds(index, c.Len())
+	case other == nil || other.IsEmpty():
+		return nil
+	}
+	first, last, n := other.head, other.tail, other.len
+	other.moveNodesTo(c.identity())
+
+	if c.IsEmpty() {
+		// the moved nodes already form a ring, or are a single node without links
+		c.head, c.tail = first, last
+	} else {
+		// in a circle, both the positions 0 and len are between the tail and the head
+		pred, succ := c.tail, c.head
+		if index > 0 && index < c.Len() {
+			succ = c.nodeAt(index)
+			pred = succ.prev
+		}
+		pred.next, first.prev = first, pred
+		last.next, succ.prev = succ, last
+		switch index {
+		case 0:
+			c.head = first
+		case c.Len():
+			c.tail = last
+		}
+	}
+	c.len += n
+	c.modCount++
+	return nil
+}
+
+// Concat moves all the elements of other to the end (before the head) of this list, in order, leaving other empty.
+// It is equivalent to c.Splice(c.Len(), other), and takes O(1) time.
+func (c *CircularLinkedList[T]) Concat(other *CircularLinkedList[T]) error {
+	return c.Splice(c.Len(), other)
+}
+
+// moveNodesTo empties the list without unlinking its nodes, which are being moved to the list owning owner.
+func (c *CircularLinkedList[T]) moveNodesTo(owner *nodeOwner) {
+	// the moved nodes now belong to the destination, and this list gets a new owner for the nodes added later
+	c.owner.forward, c.owner = owner, nil
+	c.head, c.tail, c.len = nil, nil, 0
+	c.modCount++
+}
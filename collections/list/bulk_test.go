@@ -0,0 +1,237 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+)
+
+// bulkList is the bulk API shared by the list types.
+type bulkList[T any] interface {
+	LinkedList[T]
+	AddAll(seq iter.Seq[T]) LinkedList[T]
+	InsertAll(index int, seq iter.Seq[T]) (bool, error)
+	Clear()
+}
+
+func TestLinkedList_AddAll(t *testing.T) {
+	for name, list := range encodableLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			l := list.(bulkList[int])
+			l.AddAll(slices.Values([]int{}))
+			checkEnds(t, l, []int{})
+			if got := l.AddAll(slices.Values([]int{1, 2})); got != l {
+				t.Errorf("AddAll() returned %v, want the receiver", got)
+			}
+			l.AddAll(slices.Values([]int{3})).AddLast(4)
+			checkEnds(t, l, []int{1, 2, 3, 4})
+		})
+	}
+}
+
+func TestLinkedList_InsertAll(t *testing.T) {
+	tests := []struct {
+		values   []int
+		index    int
+		inserted []int
+	}{
+		{[]int{}, 0, []int{}},
+		{[]int{}, 0, []int{7, 8}},
+		{[]int{1}, 0, []int{7}},
+		{[]int{1}, 1, []int{7, 8}},
+		{[]int{1, 2, 3}, 0, []int{7, 8, 9}},
+		{[]int{1, 2, 3}, 1, []int{7, 8}},
+		{[]int{1, 2, 3}, 2, []int{7, 8, 9}},
+		{[]int{1, 2, 3}, 3, []int{7, 8}},
+		{[]int{1, 2, 3}, 2, []int{}},
+	}
+	for _, lt := range allLinkedListTypes {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%v/%v/%v/%v", lt, tt.values, tt.index, tt.inserted), func(t *testing.T) {
+				l := NewLinkedListFromSlice(lt, tt.values).(bulkList[int])
+				if ok, err := l.InsertAll(tt.index, slices.Values(tt.inserted)); !ok || err != nil {
+					t.Fatalf("InsertAll() = %v, %v, want true, nil", ok, err)
+				}
+				want := slices.Insert(slices.Clone(tt.values), tt.index, tt.inserted...)
+				checkEnds(t, l, want)
+			})
+		}
+		t.Run(lt.String()+"/out of bounds", func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 1, 2).(bulkList[int])
+			for _, index := range []int{-1, 3} {
+				if ok, err := l.InsertAll(index, slices.Values([]int{9})); ok || !errors.Is(err, ErrIndexOutOfBounds) {
+					t.Errorf("InsertAll(%v) = %v, %v, want false, %v", index, ok, err, ErrIndexOutOfBounds)
+				}
+			}
+			checkEnds(t, l, []int{1, 2})
+		})
+	}
+}
+
+func TestLinkedList_Clear(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		for _, values := range [][]int{{}, {1}, {1, 2}, {1, 2, 3}} {
+			t.Run(fmt.Sprintf("%v/%v", lt, values), func(t *testing.T) {
+				l := NewLinkedListFromSlice(lt, values).(bulkList[int])
+				l.Clear()
+				checkEnds(t, l, []int{})
+				l.AddLast(4).AddFirst(3)
+				checkEnds(t, l, []int{3, 4})
+			})
+		}
+	}
+	for name, newList := range handleLists[int]() {
+		t.Run(name+"/handles", func(t *testing.T) {
+			l := newList()
+			h := l.AddLastHandle(1)
+			l.(bulkList[int]).Clear()
+			if _, err := l.Remove(h); !errors.Is(err, ErrInvalidHandle) {
+				t.Errorf("Remove() after Clear() error = %v, want %v", err, ErrInvalidHandle)
+			}
+		})
+	}
+}
+
+func TestLinkedList_Clone(t *testing.T) {
+	s := NewLinkedListFrom(SinglyLinked, 1, 2, 3).(*SinglyLinkedList[int])
+	d := NewLinkedListFrom(DoublyLinked, 1, 2, 3).(*DoublyLinkedList[int])
+	c := NewLinkedListFrom(Circular, 1, 2, 3).(*CircularLinkedList[int])
+	clones := map[string]struct{ original, clone LinkedList[int] }{
+		SinglyLinked.String(): {s, s.Clone()},
+		DoublyLinked.String(): {d, d.Clone()},
+		Circular.String():     {c, c.Clone()},
+	}
+	for name, tt := range clones {
+		t.Run(name, func(t *testing.T) {
+			checkEnds(t, tt.clone, []int{1, 2, 3})
+			// the clone and the original are independent
+			tt.clone.RemoveFirst()
+			tt.original.AddLast(4)
+			checkEnds(t, tt.clone, []int{2, 3})
+			checkEnds(t, tt.original, []int{1, 2, 3, 4})
+		})
+	}
+	if h, _ := d.FirstHandle(); d.Clone().Update(h, 9) == nil {
+		t.Errorf("Update() of a clone with a handle of the original error = nil, want %v", ErrInvalidHandle)
+	}
+}
+
+// spliceList is a list that can splice lists of its own type L.
+type spliceList[L any] interface {
+	handleList[int]
+	Splice(index int, other L) error
+	Concat(other L) error
+}
+
+func TestDoublyLinkedList_Splice(t *testing.T) {
+	testSplice(t, func() *DoublyLinkedList[int] { return &DoublyLinkedList[int]{} })
+}
+
+func TestCircularLinkedList_Splice(t *testing.T) {
+	testSplice(t, func() *CircularLinkedList[int] { return &CircularLinkedList[int]{} })
+}
+
+func testSplice[L spliceList[L]](t *testing.T, newList func() L) {
+	from := func(values []int) L {
+		l := newList()
+		for _, v := range values {
+			l.AddLast(v)
+		}
+		return l
+	}
+	tests := []struct {
+		dst   []int
+		index int
+		src   []int
+	}{
+		{[]int{}, 0, []int{}},
+		{[]int{}, 0, []int{7}},
+		{[]int{}, 0, []int{7, 8, 9}},
+		{[]int{1}, 0, []int{7}},
+		{[]int{1}, 1, []int{7}},
+		{[]int{1}, 0, []int{7, 8}},
+		{[]int{1}, 1, []int{7, 8}},
+		{[]int{1, 2, 3}, 0, []int{7, 8}},
+		{[]int{1, 2, 3}, 1, []int{7}},
+		{[]int{1, 2, 3}, 2, []int{7, 8, 9}},
+		{[]int{1, 2, 3}, 3, []int{7, 8}},
+		{[]int{1, 2, 3}, 1, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v/%v/%v", tt.dst, tt.index, tt.src), func(t *testing.T) {
+			dst, src := from(tt.dst), from(tt.src)
+			if err := dst.Splice(tt.index, src); err != nil {
+				t.Fatalf("Splice() error = %v", err)
+			}
+			checkEnds(t, dst, slices.Insert(slices.Clone(tt.dst), tt.index, tt.src...))
+			checkEnds(t, src, []int{})
+			// both lists stay usable at both ends
+			dst.AddFirst(0)
+			dst.AddLast(10)
+			src.AddLast(5)
+			checkEnds(t, dst, append(append([]int{0}, slices.Insert(slices.Clone(tt.dst), tt.index, tt.src...)...), 10))
+			checkEnds(t, src, []int{5})
+		})
+	}
+
+	t.Run("Concat", func(t *testing.T) {
+		dst, src := from([]int{1, 2}), from([]int{3, 4})
+		if err := dst.Concat(src); err != nil {
+			t.Fatalf("Concat() error = %v", err)
+		}
+		checkEnds(t, dst, []int{1, 2, 3, 4})
+		checkEnds(t, src, []int{})
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		dst, src := from([]int{1, 2}), from([]int{3})
+		if err := dst.Splice(3, src); !errors.Is(err, ErrIndexOutOfBounds) {
+			t.Errorf("Splice(3) error = %v, want %v", err, ErrIndexOutOfBounds)
+		}
+		if err := dst.Splice(-1, src); !errors.Is(err, ErrIndexOutOfBounds) {
+			t.Errorf("Splice(-1) error = %v, want %v", err, ErrIndexOutOfBounds)
+		}
+		if err := dst.Splice(0, dst); !errors.Is(err, ErrIllegalArgument) {
+			t.Errorf("Splice(0, itself) error = %v, want %v", err, ErrIllegalArgument)
+		}
+		checkEnds(t, dst, []int{1, 2})
+		checkEnds(t, src, []int{3})
+	})
+
+	t.Run("handles", func(t *testing.T) {
+		a, b, c := newList(), newList(), newList()
+		h1 := a.AddLastHandle(1)
+		h2 := a.AddLastHandle(2)
+		h3 := b.AddLastHandle(3)
+		b.Concat(a)
+		// the handles moved with their elements
+		if err := b.MoveToFront(h2); err != nil {
+			t.Errorf("MoveToFront() of a moved handle error = %v", err)
+		}
+		if _, err := a.Remove(h1); !errors.Is(err, ErrInvalidHandle) {
+			t.Errorf("Remove() from the source error = %v, want %v", err, ErrInvalidHandle)
+		}
+		// the source gets a new identity, the old handles stay invalid in it
+		h4 := a.AddLastHandle(4)
+		if err := a.Update(h1, 9); !errors.Is(err, ErrInvalidHandle) {
+			t.Errorf("Update() in the reused source error = %v, want %v", err, ErrInvalidHandle)
+		}
+		// handles follow the elements through several splices
+		c.Splice(0, b)
+		c.Splice(1, a)
+		for _, h := range []Handle[int]{h1, h2, h3, h4} {
+			if err := c.MoveToBack(h); err != nil {
+				t.Errorf("MoveToBack(%v) error = %v", h.Value(), err)
+			}
+		}
+		checkEnds[int](t, c, []int{1, 2, 3, 4})
+		if v, err := c.Remove(h3); err != nil || v != 3 {
+			t.Errorf("Remove() = %v, %v, want 3, nil", v, err)
+		}
+		if _, err := b.Remove(h2); !errors.Is(err, ErrInvalidHandle) {
+			t.Errorf("Remove() from an intermediate list error = %v, want %v", err, ErrInvalidHandle)
+		}
+	})
+}
@@ -9,102 +9,31 @@ import (
 type CircularLinkedList[T any] struct {
 	head, tail *doublyLinkedNode[T]
 	len        int
+	modCount   int         // number of structural modifications, used to detect concurrent modification
+	owner      *nodeOwner  // identifies the nodes of this list, see Handle
+	finger     finger[T]   // last node found by index
+	pool       NodePool[T] // provides and recycles the nodes, nil to allocate them
 }
 
 func (c *CircularLinkedList[T]) AddLast(e T) LinkedList[T] {
-	newNode := &doublyLinkedNode[T]{value: e}
-	// if this is the first item
-	if c.IsEmpty() {
-		c.tail = newNode
-		c.head = c.tail
-		c.len, newNode = c.len+1, nil
-		return c
-	}
-	newNode.next, newNode.prev = c.head, c.tail
-	c.tail.next = newNode
-	c.head.prev = newNode
-	c.tail = newNode
-	c.len, newNode = c.len+1, nil
+	c.linkBefore(e, nil)
 	return c
 }
 
 func (c *CircularLinkedList[T]) AddFirst(e T) LinkedList[T] {
-	newNode := &doublyLinkedNode[T]{value: e}
-	// if this is the first item
-	if c.IsEmpty() {
-		c.head = newNode
-		c.tail = c.head
-		c.len, newNode = c.len+1, nil
-		return c
-	}
-	newNode.next, newNode.prev = c.head, c.tail
-	c.head.prev = newNode
-	c.tail.next = newNode
-	c.head = newNode
-	c.len, newNode = c.len+1, nil
+	c.linkAfter(e, nil)
 	return c
 }
 
 func (c *CircularLinkedList[T]) Insert(e T, index int) (bool, error) {
-	switch {
-	case index < 0 || index > c.Len():
+	if index < 0 || index > c.Len() {
 		return false, errIndexOutOfBounds(index, c.Len())
-	case index == 0:
-		c.AddFirst(e)
-		return true, nil
-	case index == c.Len():
-		c.AddLast(e)
-		return true, nil
-	case index == 1:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = c.head.next, c.head
-		c.head.next.prev = newNode
-		c.head.next = newNode
-		c.len, newNode = c.len+1, nil
-		return true, nil
-	case index == 2:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = c.head.next.next, c.head.next
-		c.head.next.next.prev = newNode
-		c.head.next.next = newNode
-		c.len, newNode = c.len+1, nil
-		return true, nil
-	case index == c.Len()-1:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = c.tail, c.tail.prev
-		c.tail.prev.next = newNode
-		c.tail.prev = newNode
-		c.len, newNode = c.len+1, nil
-		return true, nil
-	case index == c.Len()-2:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = c.tail.prev, c.tail.prev.prev
-		c.tail.prev.prev.next = newNode
-		c.tail.prev.prev = newNode
-		c.len, newNode = c.len+1, nil
-		return true, nil
-	default:
-		newNode := &doublyLinkedNode[T]{value: e}
-		cur := c.head.next.next
-		if index < c.Len()/2 {
-			// position is left of mid, start from head.
-			for i := 2; cur != nil && i < index; i++ {
-				cur = cur.next
-			}
-		} else {
-			// index is >= mid, so position is mid, or it's right, start from the tail
-			cur := c.tail.prev.prev
-			for i := c.Len() - 3; cur != nil && i > index; i-- {
-				cur = cur.prev
-			}
-		}
-		newNode.next, newNode.prev = cur, cur.prev
-		cur.prev.next = newNode
-		cur.prev, c.len = newNode, c.len+1
-
-		newNode, cur = nil, nil
-		return true, nil
 	}
+	// nodeAt returns nil for index == Len(), which links the new node as the tail.
+	n := c.linkBefore(e, c.nodeAt(index))
+	// keep the finger on the inserted node, so that inserting at nearby indexes stays cheap
+	c.finger.set(n, index, c.modCount)
+	return true, nil
 }
 
 func (c *CircularLinkedList[T]) GetFirst() (T, error) {
@@ -124,36 +53,11 @@ func (c *CircularLinkedList[T]) GetLast() (T, error) {
 }
 
 func (c *CircularLinkedList[T]) Get(index int) (T, error) {
-	switch {
-	case c.IsEmpty() || index < 0 || index >= c.Len():
+	if c.IsEmpty() || index < 0 || index >= c.Len() {
 		var zero T
 		return zero, errIndexOutOfBounds(index, c.Len())
-	case index == 0:
-		return c.GetFirst()
-	case index == c.Len()-1:
-		return c.GetLast()
-	case index == 1:
-		return c.head.next.value, nil
-	case index == c.Len()-2:
-		return c.tail.prev.value, nil
-	default:
-		cur := c.tail.prev.prev
-		if index > (c.Len() / 2) {
-			// index right side of mid, so start from tail
-			for i := c.Len() - 3; cur != nil && i > index; i-- {
-				cur = cur.prev
-			}
-		} else {
-			// index left of mid, so start from head
-			cur = c.head.next.next
-			for i := 2; cur != nil && i < index; i++ {
-				cur = cur.next
-			}
-		}
-		value := cur.value
-		cur = nil // avoid memory leaks
-		return value, nil
 	}
+	return c.nodeAt(index).value, nil
 }
 
 func (c *CircularLinkedList[T]) GetHeadNode() (ImmutableNode[T], error) {
@@ -179,102 +83,68 @@ func (c *CircularLinkedList[T]) IsEmpty() bool {
 }
 
 func (c *CircularLinkedList[T]) RemoveFirst() (T, error) {
-	if c.Len() <= 1 {
-		return c.removeZeroOrOne()
+	if c.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
 	}
-	rm := c.head
-
-	c.head = rm.next
-	c.head.prev = c.tail
-	c.tail.next = c.head
-
-	rm.next, rm.prev = nil, nil // avoid memory leaks
-	ret := rm.value
-	c.len, rm = c.len-1, nil
-	return ret, nil
+	return c.remove(c.head), nil
 }
 
 func (c *CircularLinkedList[T]) RemoveLast() (T, error) {
-	if c.Len() <= 1 {
-		return c.removeZeroOrOne()
+	if c.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
 	}
-	rm := c.tail
-	c.tail = rm.prev
-	c.head.prev = rm.prev
-	rm.prev.next = c.head
-	ret := rm.value
-	// avoid memory leaks
-	rm.next, rm.prev = nil, nil
-	c.len, rm = c.len-1, nil
-	return ret, nil
+	return c.remove(c.tail), nil
 }
 
 func (c *CircularLinkedList[T]) RemoveAt(index int) (T, error) {
-	switch {
-	case c.IsEmpty() || index < 0 || index >= c.Len():
+	if c.IsEmpty() || index < 0 || index >= c.Len() {
 		var zero T
 		return zero, errIndexOutOfBounds(index, c.Len())
-	case index == 0:
-		return c.RemoveFirst()
-	case index == c.Len()-1:
-		return c.RemoveLast()
-	case index == 1:
-		removed, rv := c.head.next, c.head.next.value
-		c.head.next, removed.next.prev = removed.next, c.head
-		c.len, removed.next, removed.prev, removed = c.len-1, nil, nil, nil
-		return rv, nil
-	case index == c.Len()-2:
-		removed, rv := c.tail.prev, c.tail.prev.value
-		c.tail.prev, removed.prev.next = removed.prev, c.tail
-		c.len, removed.next, removed.prev, removed = c.len-1, nil, nil, nil
-		return rv, nil
-	default:
-		cur := c.tail.prev.prev
-		if index > (c.Len() / 2) {
-			// index right side of mid, so start from tail
-			for i := c.Len() - 3; cur != nil && i > index; i-- {
-				cur = cur.prev
-			}
-		} else {
-			// index left of mid, so start from head
-			cur = c.head.next.next
-			for i := 2; cur != nil && i < index; i++ {
-				cur = cur.next
-			}
-		}
-		rv := cur.value
-		cur.prev.next, cur.next.prev = cur.next, cur.prev
-		cur.next, cur.prev, cur, c.len = nil, nil, nil, c.len-1
-		return rv, nil
 	}
+	n := c.nodeAt(index)
+	next := n.next
+	value := c.remove(n)
+	if index < c.Len() {
+		// keep the finger on the node that took the place of the removed one
+		c.finger.set(next, index, c.modCount)
+	}
+	return value, nil
 }
 
 func (c *CircularLinkedList[T]) All() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
+		expectedModCount := c.modCount
 		for i, cur := 0, c.head; i < c.Len(); i, cur = i+1, cur.next {
 			if !yield(i, cur.value) {
 				return
 			}
+			c.checkModCount(expectedModCount)
 		}
 	}
 }
 
 func (c *CircularLinkedList[T]) Values() iter.Seq[T] {
 	return func(yield func(T) bool) {
+		expectedModCount := c.modCount
 		for i, cur := 0, c.head; i < c.Len(); i, cur = i+1, cur.next {
 			if !yield(cur.value) {
 				return
 			}
+			c.checkModCount(expectedModCount)
 		}
 	}
 }
 
 func (c *CircularLinkedList[T]) ReverseAll() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
+		expectedModCount := c.modCount
 		for i, cur := c.Len()-1, c.tail; i >= 0; i, cur = i-1, cur.prev {
 			if !yield(i, cur.value) {
 				return
 			}
+			c.checkModCount(expectedModCount)
 		}
 	}
 }
@@ -308,12 +178,133 @@ func (c *CircularLinkedList[T]) String() string {
 	return sb.String()
 }
 
-func (c *CircularLinkedList[T]) removeZeroOrOne() (T, error) {
-	if c.IsEmpty() {
-		var zero T
-		return zero, errNoSuchElement()
+func (c *CircularLinkedList[T]) first() *doublyLinkedNode[T] {
+	return c.head
+}
+
+func (c *CircularLinkedList[T]) last() *doublyLinkedNode[T] {
+	return c.tail
+}
+
+// identity returns the owner of the nodes of this list, creating it on first use.
+func (c *CircularLinkedList[T]) identity() *nodeOwner {
+	if c.owner == nil {
+		c.owner = &nodeOwner{}
 	}
-	value := c.head.value
-	c.len, c.head, c.tail = c.len-1, nil, nil
-	return value, nil
+	return c.owner
+}
+
+// nodeAt returns the node at the given index, walking from the nearest of the head, the tail
+// and the last node found by index (see finger). It returns nil if the index is out of bounds.
+func (c *CircularLinkedList[T]) nodeAt(index int) *doublyLinkedNode[T] {
+	if index < 0 || index >= c.Len() {
+		return nil
+	}
+	return c.finger.nodeAt(index, c.len, c.modCount, c.head, c.tail)
+}
+
+// linkAfter links a new node holding e right after pred and returns the new node.
+// If pred is nil, the new node becomes the head of the list.
+func (c *CircularLinkedList[T]) linkAfter(e T, pred *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	return c.linkNodeAfter(newNode(c.pool, e), pred)
+}
+
+// linkBefore links a new node holding e right before succ and returns the new node.
+// If succ is nil, the new node becomes the tail of the list.
+func (c *CircularLinkedList[T]) linkBefore(e T, succ *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	return c.linkNodeBefore(newNode(c.pool, e), succ)
+}
+
+// linkNodeAfter links the unlinked node n right after pred and returns n.
+// If pred is nil, n becomes the head of the list.
+func (c *CircularLinkedList[T]) linkNodeAfter(n, pred *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	n.owner = c.identity()
+	c.len++
+	c.modCount++
+	if c.len == 1 {
+		c.head, c.tail = n, n
+		return n
+	}
+	newHead := pred == nil
+	if newHead {
+		// in a circle, the position before head is the position after tail
+		pred = c.tail
+	}
+	succ := pred.next
+	if pred == c.tail {
+		succ = c.head // a single node has no links to itself
+	}
+	n.prev, n.next = pred, succ
+	pred.next, succ.prev = n, n
+	switch {
+	case newHead:
+		c.head = n
+	case pred == c.tail:
+		c.tail = n
+	}
+	return n
+}
+
+// linkNodeBefore links the unlinked node n right before succ and returns n.
+// If succ is nil, n becomes the tail of the list.
+func (c *CircularLinkedList[T]) linkNodeBefore(n, succ *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	switch {
+	case succ == nil:
+		return c.linkNodeAfter(n, c.tail)
+	case succ == c.head:
+		return c.linkNodeAfter(n, nil)
+	default:
+		return c.linkNodeAfter(n, succ.prev)
+	}
+}
+
+// unlink removes the node n from the list and returns its value.
+func (c *CircularLinkedList[T]) unlink(n *doublyLinkedNode[T]) T {
+	value := n.value
+	if c.Len() == 1 {
+		c.head, c.tail = nil, nil
+	} else {
+		n.prev.next, n.next.prev = n.next, n.prev
+		if n == c.head {
+			c.head = n.next
+		}
+		if n == c.tail {
+			c.tail = n.prev
+		}
+		if c.Len() == 2 {
+			// a single node has no links to itself
+			c.head.next, c.head.prev = nil, nil
+		}
+	}
+	n.next, n.prev, n.owner = nil, nil, nil
+	c.len--
+	c.modCount++
+	return value
+}
+
+// remove unlinks the node n of a removed element and gives it back to the pool, and returns its value.
+// Unlike unlink, it must not be used to move a node within the list.
+func (c *CircularLinkedList[T]) remove(n *doublyLinkedNode[T]) T {
+	value := c.unlink(n)
+	releaseNode(c.pool, n)
+	return value
+}
+
+// unshare does nothing, as a CircularLinkedList has no snapshots.
+func (c *CircularLinkedList[T]) unshare(...**doublyLinkedNode[T]) {}
+
+// checkModCount panics with an [ErrConcurrentModification] error
+// if the list was structurally modified since expectedModCount was read.
+func (c *CircularLinkedList[T]) checkModCount(expectedModCount int) {
+	if c.modCount != expectedModCount {
+		panic(errConcurrentModification())
+	}
+}
+
+func (c *CircularLinkedList[T]) modifications() int {
+	return c.modCount
+}
+
+func (c *CircularLinkedList[T]) listType() linkedListType {
+	return Circular
 }
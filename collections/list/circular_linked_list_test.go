@@ -341,6 +341,17 @@ func TestCircularLinkedList_Insert(t *testing.T) {
 	})
 }
 
+func TestCircularLinkedList_InsertRightOfMid(t *testing.T) {
+	list := NewLinkedListFrom[int](Circular, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	if ok, err := list.Insert(100, 6); !ok || err != nil {
+		t.Fatalf("Insert(%v, %v) got = %v, %v expected true, nil", 100, 6, ok, err)
+	}
+	want := []int{0, 1, 2, 3, 4, 5, 100, 6, 7, 8, 9}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Insert(%v, %v) got %v expected %v", 100, 6, got, want)
+	}
+}
+
 func TestCircularLinkedList_RemoveFirst(t *testing.T) {
 	list := NewLinkedList[int](Circular)
 	// remove first from empty list - should return ErrNoSuchElement
@@ -0,0 +1,118 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+// expectConcurrentModification runs f and reports an error unless it panics with an ErrConcurrentModification error.
+func expectConcurrentModification(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, ErrConcurrentModification) {
+			t.Errorf("recovered %v, want panic with %v", r, ErrConcurrentModification)
+		}
+	}()
+	f()
+}
+
+func TestLinkedList_IteratorsFailFast(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String()+"/All", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			expectConcurrentModification(t, func() {
+				for i := range list.All() {
+					if i == 0 {
+						list.RemoveFirst()
+					}
+				}
+			})
+		})
+		t.Run(lt.String()+"/Values", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			expectConcurrentModification(t, func() {
+				for v := range list.Values() {
+					if v == 3 {
+						list.AddLast(4)
+					}
+				}
+			})
+		})
+		t.Run(lt.String()+"/ReverseAll", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			expectConcurrentModification(t, func() {
+				for i := range list.ReverseAll() {
+					if i == 1 {
+						list.Insert(9, 1)
+					}
+				}
+			})
+		})
+		t.Run(lt.String()+"/no modification", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			sum := 0
+			for v := range list.Values() {
+				sum += v
+			}
+			// modification after a completed or broken iteration is fine
+			for range list.All() {
+				list.AddFirst(0)
+				break
+			}
+			if sum != 6 || list.Len() != 4 {
+				t.Errorf("sum, Len() = %d, %d, want %d, %d", sum, list.Len(), 6, 4)
+			}
+		})
+	}
+}
+
+func TestListIterator_FailFast(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			it := listIteratorOf(list)
+			it.Next()
+			list.RemoveAt(1)
+			if _, err := it.Next(); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("Next() error = %v, want %v", err, ErrConcurrentModification)
+			}
+			if _, err := it.Prev(); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("Prev() error = %v, want %v", err, ErrConcurrentModification)
+			}
+			if err := it.Set(0); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("Set() error = %v, want %v", err, ErrConcurrentModification)
+			}
+			if _, err := it.Remove(); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("Remove() error = %v, want %v", err, ErrConcurrentModification)
+			}
+			if err := it.InsertBefore(0); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("InsertBefore() error = %v, want %v", err, ErrConcurrentModification)
+			}
+			if err := it.InsertAfter(0); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("InsertAfter() error = %v, want %v", err, ErrConcurrentModification)
+			}
+		})
+		t.Run(lt.String()+"/own modifications", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			it := listIteratorOf(list)
+			for it.HasNext() {
+				v, err := it.Next()
+				if err != nil {
+					t.Fatalf("Next() error = %v", err)
+				}
+				switch v {
+				case 1:
+					it.Remove()
+				case 2:
+					it.InsertAfter(5)
+				case 3:
+					it.InsertBefore(4)
+				}
+			}
+			checkEnds(t, list, []int{2, 5, 4, 3})
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/hegdevenky/go_commons/collections/list"
+	"github.com/hegdevenky/go_commons/collections/list/listtest"
+)
+
+func TestConformance(t *testing.T) {
+	factories := map[string]func() list.LinkedList[int]{
+		list.SinglyLinked.String(): func() list.LinkedList[int] { return list.NewLinkedList[int](list.SinglyLinked) },
+		list.DoublyLinked.String(): func() list.LinkedList[int] { return list.NewLinkedList[int](list.DoublyLinked) },
+		list.Circular.String():     func() list.LinkedList[int] { return list.NewLinkedList[int](list.Circular) },
+		list.Unrolled.String():     func() list.LinkedList[int] { return list.NewLinkedList[int](list.Unrolled) },
+		"SYNCHRONIZED":             func() list.LinkedList[int] { return list.Synchronized[int](nil) },
+		"JOURNAL":                  func() list.LinkedList[int] { return list.NewJournal(list.NewLinkedList[int](list.Circular)) },
+		"OBSERVABLE": func() list.LinkedList[int] {
+			return list.Observable(list.NewLinkedList[int](list.SinglyLinked))
+		},
+		"DOUBLY_LINKED/FreeListPool": func() list.LinkedList[int] {
+			return list.NewDoublyLinkedListWithPool(list.NewFreeListPool[int](4))
+		},
+		"DOUBLY_LINKED/SyncPool": func() list.LinkedList[int] {
+			return list.NewDoublyLinkedListWithPool(list.NewSyncPool[int]())
+		},
+		"CIRCULAR/ArenaPool": func() list.LinkedList[int] {
+			return list.NewCircularLinkedListWithPool(list.NewArenaPool[int](3))
+		},
+	}
+	// views of an empty window between two elements, which must never be visible through the view
+	for _, lt := range []string{list.SinglyLinked.String(), list.DoublyLinked.String(), list.Circular.String()} {
+		newParent := factories[lt]
+		factories[lt+"/SubList"] = func() list.LinkedList[int] {
+			parent := newParent().AddLast(-1).AddLast(-2).(interface {
+				SubList(from, to int) (*list.ListView[int], error)
+			})
+			view, _ := parent.SubList(1, 1)
+			return view
+		}
+	}
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			listtest.RunConformance(t, factory)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package list
+
+// The Queue interface defines a first-in-first-out (FIFO) view of a collection.
+// Elements are offered at the tail and polled from the head.
+// [DoublyLinkedList] and [CircularLinkedList] are the known implementations.
+type Queue[T any] interface {
+	// The Offer method adds the given element to the tail of the queue.
+	Offer(e T)
+
+	// The Poll method removes and returns the element at the head of the queue.
+	// If the queue is empty, it returns an [ErrNoSuchElement] error.
+	Poll() (T, error)
+
+	// The Peek method returns the element at the head of the queue without removing it.
+	// If the queue is empty, it returns an [ErrNoSuchElement] error.
+	Peek() (T, error)
+
+	// The Len method returns the number of elements in the queue.
+	Len() int
+
+	// The IsEmpty method returns true if the queue has no elements.
+	IsEmpty() bool
+}
+
+// The Stack interface defines a last-in-first-out (LIFO) view of a collection.
+// Elements are pushed to and popped from the top of the stack, which is the head of the underlying list.
+// [DoublyLinkedList] and [CircularLinkedList] are the known implementations.
+type Stack[T any] interface {
+	// The Push method adds the given element to the top of the stack.
+	Push(e T)
+
+	// The Pop method removes and returns the element at the top of the stack.
+	// If the stack is empty, it returns an [ErrNoSuchElement] error.
+	Pop() (T, error)
+
+	// The Peek method returns the element at the top of the stack without removing it.
+	// If the stack is empty, it returns an [ErrNoSuchElement] error.
+	Peek() (T, error)
+
+	// The Len method returns the number of elements in the stack.
+	Len() int
+
+	// The IsEmpty method returns true if the stack has no elements.
+	IsEmpty() bool
+}
+
+// The Deque interface defines a double-ended queue, which supports adding and removing elements at both ends.
+// A Deque can be used as a [Queue] (offer last, poll first) and as a [Stack] (push first, pop first).
+// [DoublyLinkedList] and [CircularLinkedList] are the known implementations.
+type Deque[T any] interface {
+	Queue[T]
+	Stack[T]
+
+	// The OfferFirst method adds the given element to the head of the deque.
+	OfferFirst(e T)
+
+	// The OfferLast method adds the given element to the tail of the deque.
+	OfferLast(e T)
+
+	// The PollFirst method removes and returns the element at the head of the deque.
+	// If the deque is empty, it returns an [ErrNoSuchElement] error.
+	PollFirst() (T, error)
+
+	// The PollLast method removes and returns the element at the tail of the deque.
+	// If the deque is empty, it returns an [ErrNoSuchElement] error.
+	PollLast() (T, error)
+
+	// The PeekFirst method returns the element at the head of the deque without removing it.
+	// If the deque is empty, it returns an [ErrNoSuchElement] error.
+	PeekFirst() (T, error)
+
+	// The PeekLast method returns the element at the tail of the deque without removing it.
+	// If the deque is empty, it returns an [ErrNoSuchElement] error.
+	PeekLast() (T, error)
+}
+
+var (
+	_ Deque[any] = (*DoublyLinkedList[any])(nil)
+	_ Deque[any] = (*CircularLinkedList[any])(nil)
+)
+
+// DoublyLinkedList as Deque, Queue and Stack.
+
+func (d *DoublyLinkedList[T]) OfferFirst(e T) {
+	d.AddFirst(e)
+}
+
+func (d *DoublyLinkedList[T]) OfferLast(e T) {
+	d.AddLast(e)
+}
+
+func (d *DoublyLinkedList[T]) PollFirst() (T, error) {
+	return d.RemoveFirst()
+}
+
+func (d *DoublyLinkedList[T]) PollLast() (T, error) {
+	return d.RemoveLast()
+}
+
+func (d *DoublyLinkedList[T]) PeekFirst() (T, error) {
+	return d.GetFirst()
+}
+
+func (d *DoublyLinkedList[T]) PeekLast() (T, error) {
+	return d.GetLast()
+}
+
+func (d *DoublyLinkedList[T]) Offer(e T) {
+	d.OfferLast(e)
+}
+
+func (d *DoublyLinkedList[T]) Poll() (T, error) {
+	return d.PollFirst()
+}
+
+func (d *DoublyLinkedList[T]) Peek() (T, error) {
+	return d.PeekFirst()
+}
+
+func (d *DoublyLinkedList[T]) Push(e T) {
+	d.OfferFirst(e)
+}
+
+func (d *DoublyLinkedList[T]) Pop() (T, error) {
+	return d.PollFirst()
+}
+
+// CircularLinkedList as Deque, Queue and Stack.
+
+func (c *CircularLinkedList[T]) OfferFirst(e T) {
+	c.AddFirst(e)
+}
+
+func (c *CircularLinkedList[T]) OfferLast(e T) {
+	c.AddLast(e)
+}
+
+func (c *CircularLinkedList[T]) PollFirst() (T, error) {
+	return c.RemoveFirst()
+}
+
+func (c *CircularLinkedList[T]) PollLast() (T, error) {
+	return c.RemoveLast()
+}
+
+func (c *CircularLinkedList[T]) PeekFirst() (T, error) {
+	return c.GetFirst()
+}
+
+func (c *CircularLinkedList[T]) PeekLast() (T, error) {
+	return c.GetLast()
+}
+
+func (c *CircularLinkedList[T]) Offer(e T) {
+	c.OfferLast(e)
+}
+
+func (c *CircularLinkedList[T]) Poll() (T, error) {
+	return c.PollFirst()
+}
+
+func (c *CircularLinkedList[T]) Peek() (T, error) {
+	return c.PeekFirst()
+}
+
+func (c *CircularLinkedList[T]) Push(e T) {
+	c.OfferFirst(e)
+}
+
+func (c *CircularLinkedList[T]) Pop() (T, error) {
+	return c.PollFirst()
+}
@@ -0,0 +1,108 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+// deques returns a fresh empty Deque of every implementation.
+func deques[T any]() map[string]Deque[T] {
+	return map[string]Deque[T]{
+		DoublyLinked.String(): &DoublyLinkedList[T]{},
+		Circular.String():     &CircularLinkedList[T]{},
+	}
+}
+
+func TestDeque_Empty(t *testing.T) {
+	for name, dq := range deques[int]() {
+		t.Run(name, func(t *testing.T) {
+			if !dq.IsEmpty() || dq.Len() != 0 {
+				t.Fatalf("IsEmpty(), Len() = %t, %d, want %t, %d", dq.IsEmpty(), dq.Len(), true, 0)
+			}
+			for opName, op := range map[string]func() (int, error){
+				"PollFirst": dq.PollFirst, "PollLast": dq.PollLast,
+				"PeekFirst": dq.PeekFirst, "PeekLast": dq.PeekLast,
+				"Poll": dq.Poll, "Pop": dq.Pop, "Peek": dq.Peek,
+			} {
+				if got, err := op(); got != 0 || !errors.Is(err, ErrNoSuchElement) {
+					t.Errorf("%s() = %v, %v, want %v, %v", opName, got, err, 0, ErrNoSuchElement)
+				}
+			}
+		})
+	}
+}
+
+func TestDeque_BothEnds(t *testing.T) {
+	for name, dq := range deques[string]() {
+		t.Run(name, func(t *testing.T) {
+			dq.OfferLast("b")
+			dq.OfferFirst("a")
+			dq.OfferLast("c")
+			if first, _ := dq.PeekFirst(); first != "a" {
+				t.Errorf("PeekFirst() = %v, want %v", first, "a")
+			}
+			if last, _ := dq.PeekLast(); last != "c" {
+				t.Errorf("PeekLast() = %v, want %v", last, "c")
+			}
+			if dq.Len() != 3 {
+				t.Errorf("Len() = %d, want %d", dq.Len(), 3)
+			}
+			if last, err := dq.PollLast(); last != "c" || err != nil {
+				t.Errorf("PollLast() = %v, %v, want %v, nil", last, err, "c")
+			}
+			if first, err := dq.PollFirst(); first != "a" || err != nil {
+				t.Errorf("PollFirst() = %v, %v, want %v, nil", first, err, "a")
+			}
+			if only, err := dq.PollFirst(); only != "b" || err != nil {
+				t.Errorf("PollFirst() = %v, %v, want %v, nil", only, err, "b")
+			}
+			if !dq.IsEmpty() {
+				t.Errorf("IsEmpty() = %t, want %t", dq.IsEmpty(), true)
+			}
+		})
+	}
+}
+
+func TestQueue_FIFO(t *testing.T) {
+	for name, dq := range deques[int]() {
+		t.Run(name, func(t *testing.T) {
+			var q Queue[int] = dq
+			for i := range 5 {
+				q.Offer(i)
+			}
+			if head, _ := q.Peek(); head != 0 {
+				t.Errorf("Peek() = %v, want %v", head, 0)
+			}
+			for i := range 5 {
+				if got, err := q.Poll(); got != i || err != nil {
+					t.Errorf("Poll() = %v, %v, want %v, nil", got, err, i)
+				}
+			}
+			if !q.IsEmpty() {
+				t.Errorf("IsEmpty() = %t, want %t", q.IsEmpty(), true)
+			}
+		})
+	}
+}
+
+func TestStack_LIFO(t *testing.T) {
+	for name, dq := range deques[int]() {
+		t.Run(name, func(t *testing.T) {
+			var s Stack[int] = dq
+			for i := range 5 {
+				s.Push(i)
+			}
+			if top, _ := s.Peek(); top != 4 {
+				t.Errorf("Peek() = %v, want %v", top, 4)
+			}
+			for i := 4; i >= 0; i-- {
+				if got, err := s.Pop(); got != i || err != nil {
+					t.Errorf("Pop() = %v, %v, want %v, nil", got, err, i)
+				}
+			}
+			if s.Len() != 0 {
+				t.Errorf("Len() = %d, want %d", s.Len(), 0)
+			}
+		})
+	}
+}
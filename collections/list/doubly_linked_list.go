@@ -14,97 +14,35 @@ import (
 type DoublyLinkedList[T any] struct {
 	head, tail *doublyLinkedNode[T]
 	len        int
+	modCount   int         // number of structural modifications, used to detect concurrent modification
+	owner      *nodeOwner  // identifies the nodes of this list, see Handle
+	finger     finger[T]   // last node found by index
+	pool       NodePool[T] // provides and recycles the nodes, nil to allocate them
+	shared     bool        // the nodes are shared with a Snapshot, see unshare
 }
 
 func (d *DoublyLinkedList[T]) AddLast(e T) LinkedList[T] {
-	newNode := &doublyLinkedNode[T]{value: e}
-	if d.IsEmpty() {
-		d.tail = newNode
-		d.head = d.tail
-		d.len, newNode = d.len+1, nil
-		return d
-	}
-	d.tail.next = newNode
-	newNode.prev = d.tail
-	d.tail = newNode
-	d.len, newNode = d.len+1, nil
+	// no unshare: appending only writes the link past the tail, which the snapshots do not read
+	d.linkBefore(e, nil)
 	return d
 }
 
 func (d *DoublyLinkedList[T]) AddFirst(e T) LinkedList[T] {
-	newNode := &doublyLinkedNode[T]{value: e}
-	// if this is the first item
-	if d.IsEmpty() {
-		d.head = newNode
-		d.tail = d.head
-		d.len, newNode = d.len+1, nil
-		return d
-	}
-	newNode.next = d.head
-	d.head.prev = newNode
-	d.head = newNode
-	d.len, newNode = d.len+1, nil
+	// no unshare: prepending only writes the link before the head, which the snapshots do not read
+	d.linkAfter(e, nil)
 	return d
 }
 
 func (d *DoublyLinkedList[T]) Insert(e T, index int) (bool, error) {
-	switch {
-	case index < 0 || index > d.Len():
+	if index < 0 || index > d.Len() {
 		return false, errIndexOutOfBounds(index, d.Len())
-	case index == 0:
-		d.AddFirst(e)
-		return true, nil
-	case index == d.Len():
-		d.AddLast(e)
-		return true, nil
-	case index == 1:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = d.head.next, d.head
-		d.head.next.prev = newNode
-		d.head.next = newNode
-		d.len, newNode = d.len+1, nil
-		return true, nil
-	case index == 2:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = d.head.next.next, d.head.next
-		d.head.next.next.prev = newNode
-		d.head.next.next = newNode
-		d.len, newNode = d.len+1, nil
-		return true, nil
-	case index == d.Len()-1:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = d.tail, d.tail.prev
-		d.tail.prev.next = newNode
-		d.tail.prev = newNode
-		d.len, newNode = d.len+1, nil
-		return true, nil
-	case index == d.Len()-2:
-		newNode := &doublyLinkedNode[T]{value: e}
-		newNode.next, newNode.prev = d.tail.prev, d.tail.prev.prev
-		d.tail.prev.prev.next = newNode
-		d.tail.prev.prev = newNode
-		d.len, newNode = d.len+1, nil
-		return true, nil
-	default:
-		newNode := &doublyLinkedNode[T]{value: e}
-		cur := d.head.next.next
-		if index < d.Len()/2 {
-			for i := 2; cur != nil && i < index; i++ {
-				cur = cur.next
-			}
-		} else {
-			cur = d.tail.prev.prev
-			for i := d.Len() - 3; cur != nil && i > index; i-- {
-				cur = cur.prev
-			}
-		}
-		newNode.next, newNode.prev = cur, cur.prev
-		cur.prev.next = newNode
-		cur.prev, d.len = newNode, d.len+1
-		// avoid memory leaks
-		newNode, cur = nil, nil
-		return true, nil
 	}
+	d.unshare()
+	// nodeAt returns nil for index == Len(), which links the new node as the tail.
+	n := d.linkBefore(e, d.nodeAt(index))
+	// keep the finger on the inserted node, so that inserting at nearby indexes stays cheap
+	d.finger.set(n, index, d.modCount)
+	return true, nil
 }
 
 func (d *DoublyLinkedList[T]) GetFirst() (T, error) {
@@ -124,36 +62,11 @@ func (d *DoublyLinkedList[T]) GetLast() (T, error) {
 }
 
 func (d *DoublyLinkedList[T]) Get(index int) (T, error) {
-	switch {
-	case d.IsEmpty() || index < 0 || index >= d.Len():
+	if d.IsEmpty() || index < 0 || index >= d.Len() {
 		var zero T
 		return zero, errIndexOutOfBounds(index, d.Len())
-	case index == 0:
-		return d.GetFirst()
-	case index == d.Len()-1:
-		return d.GetLast()
-	case index == 1:
-		return d.head.next.value, nil
-	case index == d.Len()-2:
-		return d.tail.prev.value, nil
-	default:
-		cur := d.tail.prev.prev
-		if index > (d.Len() / 2) {
-			// index right side of mid, so start from tail
-			for i := d.Len() - 3; cur != nil && i > index; i-- {
-				cur = cur.prev
-			}
-		} else {
-			// index left of mid, so start from head
-			cur = d.head.next.next
-			for i := 2; cur != nil && i < index; i++ {
-				cur = cur.next
-			}
-		}
-		value := cur.value
-		cur = nil // avoid memory leaks
-		return value, nil
 	}
+	return d.nodeAt(index).value, nil
 }
 
 func (d *DoublyLinkedList[T]) GetHeadNode() (ImmutableNode[T], error) {
@@ -179,97 +92,71 @@ func (d *DoublyLinkedList[T]) IsEmpty() bool {
 }
 
 func (d *DoublyLinkedList[T]) RemoveFirst() (T, error) {
-	if d.Len() <= 1 {
-		return d.removeZeroOrOne()
+	if d.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
 	}
-	rm := d.head
-	d.head = rm.next
-	d.head.prev = nil
-	rm.next, rm.prev = nil, nil
-	value := rm.value
-	d.len, rm = d.len-1, nil
-	return value, nil
+	d.unshare()
+	return d.remove(d.head), nil
 }
 
 func (d *DoublyLinkedList[T]) RemoveLast() (T, error) {
-	if d.Len() <= 1 {
-		return d.removeZeroOrOne()
+	if d.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
 	}
-	rm := d.tail
-	d.tail = rm.prev
-	d.tail.next = nil
-	rm.prev = nil
-	value := rm.value
-	d.len, rm = d.len-1, nil
-	return value, nil
+	d.unshare()
+	return d.remove(d.tail), nil
 }
 
 func (d *DoublyLinkedList[T]) RemoveAt(index int) (T, error) {
-	switch {
-	case d.IsEmpty() || index < 0 || index >= d.Len():
+	if d.IsEmpty() || index < 0 || index >= d.Len() {
 		var zero T
 		return zero, errIndexOutOfBounds(index, d.Len())
-	case index == 0:
-		return d.RemoveFirst()
-	case index == d.Len()-1:
-		return d.RemoveLast()
-	case index == 1:
-		removed, rv := d.head.next, d.head.next.value
-		d.head.next, removed.next.prev = removed.next, d.head
-		d.len, removed.next, removed.prev, removed = d.len-1, nil, nil, nil
-		return rv, nil
-	case index == d.Len()-2:
-		removed, rv := d.tail.prev, d.tail.prev.value
-		d.tail.prev, removed.prev.next = removed.prev, d.tail
-		d.len, removed.next, removed.prev, removed = d.len-1, nil, nil, nil
-		return rv, nil
-	default:
-		cur := d.tail.prev.prev
-		if index > (d.Len() / 2) {
-			// index right side of mid, so start from tail
-			for i := d.Len() - 3; cur != nil && i > index; i-- {
-				cur = cur.prev
-			}
-		} else {
-			// index left of mid, so start from head
-			cur = d.head.next.next
-			for i := 2; cur != nil && i < index; i++ {
-				cur = cur.next
-			}
-		}
-		rv := cur.value
-		cur.prev.next, cur.next.prev = cur.next, cur.prev
-		cur.next, cur.prev, cur, d.len = nil, nil, nil, d.len-1
-		return rv, nil
 	}
+	d.unshare()
+	n := d.nodeAt(index)
+	next := n.next
+	value := d.remove(n)
+	if index < d.Len() {
+		// keep the finger on the node that took the place of the removed one
+		d.finger.set(next, index, d.modCount)
+	}
+	return value, nil
 }
 
 func (d *DoublyLinkedList[T]) All() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
+		expectedModCount := d.modCount
 		for i, cur := 0, d.head; cur != nil; i, cur = i+1, cur.next {
 			if !yield(i, cur.value) {
 				return
 			}
+			d.checkModCount(expectedModCount)
 		}
 	}
 }
 
 func (d *DoublyLinkedList[T]) Values() iter.Seq[T] {
 	return func(yield func(T) bool) {
+		expectedModCount := d.modCount
 		for cur := d.head; cur != nil; cur = cur.next {
 			if !yield(cur.value) {
 				return
 			}
+			d.checkModCount(expectedModCount)
 		}
 	}
 }
 
 func (d *DoublyLinkedList[T]) ReverseAll() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
+		expectedModCount := d.modCount
 		for i, cur := d.Len()-1, d.tail; cur != nil && i >= 0; i, cur = i-1, cur.prev {
 			if !yield(i, cur.value) {
 				return
 			}
+			d.checkModCount(expectedModCount)
 		}
 	}
 }
@@ -304,12 +191,110 @@ func (d *DoublyLinkedList[T]) String() string {
 	return sb.String()
 }
 
-func (d *DoublyLinkedList[T]) removeZeroOrOne() (T, error) {
-	if d.IsEmpty() {
-		var zero T
-		return zero, errNoSuchElement()
+func (d *DoublyLinkedList[T]) first() *doublyLinkedNode[T] {
+	return d.head
+}
+
+func (d *DoublyLinkedList[T]) last() *doublyLinkedNode[T] {
+	return d.tail
+}
+
+// identity returns the owner of the nodes of this list, creating it on first use.
+func (d *DoublyLinkedList[T]) identity() *nodeOwner {
+	if d.owner == nil {
+		d.owner = &nodeOwner{}
 	}
-	value := d.head.value
-	d.len, d.head, d.tail = d.len-1, nil, nil
-	return value, nil
+	return d.owner
+}
+
+// nodeAt returns the node at the given index, walking from the nearest of the head, the tail
+// and the last node found by index (see finger). It returns nil if the index is out of bounds.
+func (d *DoublyLinkedList[T]) nodeAt(index int) *doublyLinkedNode[T] {
+	if index < 0 || index >= d.Len() {
+		return nil
+	}
+	return d.finger.nodeAt(index, d.len, d.modCount, d.head, d.tail)
+}
+
+// linkAfter links a new node holding e right after pred and returns the new node.
+// If pred is nil, the new node becomes the head of the list.
+func (d *DoublyLinkedList[T]) linkAfter(e T, pred *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	return d.linkNodeAfter(newNode(d.pool, e), pred)
+}
+
+// linkBefore links a new node holding e right before succ and returns the new node.
+// If succ is nil, the new node becomes the tail of the list.
+func (d *DoublyLinkedList[T]) linkBefore(e T, succ *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	return d.linkNodeBefore(newNode(d.pool, e), succ)
+}
+
+// linkNodeAfter links the unlinked node n right after pred and returns n.
+// If pred is nil, n becomes the head of the list.
+func (d *DoublyLinkedList[T]) linkNodeAfter(n, pred *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	n.prev, n.owner = pred, d.identity()
+	if pred == nil {
+		n.next, d.head = d.head, n
+	} else {
+		n.next, pred.next = pred.next, n
+	}
+	if n.next == nil {
+		d.tail = n
+	} else {
+		n.next.prev = n
+	}
+	d.len++
+	d.modCount++
+	return n
+}
+
+// linkNodeBefore links the unlinked node n right before succ and returns n.
+// If succ is nil, n becomes the tail of the list.
+func (d *DoublyLinkedList[T]) linkNodeBefore(n, succ *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	if succ == nil {
+		return d.linkNodeAfter(n, d.tail)
+	}
+	return d.linkNodeAfter(n, succ.prev)
+}
+
+// unlink removes the node n from the list and returns its value.
+func (d *DoublyLinkedList[T]) unlink(n *doublyLinkedNode[T]) T {
+	if n.prev == nil {
+		d.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		d.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	value := n.value
+	n.next, n.prev, n.owner = nil, nil, nil
+	d.len--
+	d.modCount++
+	return value
+}
+
+// remove unlinks the node n of a removed element and gives it back to the pool, and returns its value.
+// Unlike unlink, it must not be used to move a node within the list.
+func (d *DoublyLinkedList[T]) remove(n *doublyLinkedNode[T]) T {
+	value := d.unlink(n)
+	releaseNode(d.pool, n)
+	return value
+}
+
+// checkModCount panics with an [ErrConcurrentModification] error
+// if the list was structurally modified since expectedModCount was read.
+func (d *DoublyLinkedList[T]) checkModCount(expectedModCount int) {
+	if d.modCount != expectedModCount {
+		panic(errConcurrentModification())
+	}
+}
+
+func (d *DoublyLinkedList[T]) modifications() int {
+	return d.modCount
+}
+
+func (d *DoublyLinkedList[T]) listType() linkedListType {
+	return DoublyLinked
 }
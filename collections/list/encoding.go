@@ -0,0 +1,263 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+)
+
+// The LinkedList implementations and the exported nodes are encoded as the array (slice) of their elements,
+// in order from the head. Ex: the DoublyLinkedList 1 <=> 2 <=> 3 is encoded to JSON as [1,2,3].
+// Decoding replaces the elements of the receiver with the decoded ones.
+//
+// The text encoding is the same as the JSON encoding,
+// and the gob encoding is the gob encoding of the slice of elements.
+
+// SinglyLinkedList encoding.
+
+func (s *SinglyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+func (s *SinglyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONValues[T](data)
+	if err == nil && values != nil {
+		s.replaceAll(values)
+	}
+	return err
+}
+
+func (s *SinglyLinkedList[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+func (s *SinglyLinkedList[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+func (s *SinglyLinkedList[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(s.ToSlice())
+}
+
+func (s *SinglyLinkedList[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		s.replaceAll(values)
+	}
+	return err
+}
+
+// replaceAll removes all the elements of the list and appends the given values.
+func (s *SinglyLinkedList[T]) replaceAll(values []T) {
+	s.Clear()
+	s.AddAll(slices.Values(values))
+}
+
+// DoublyLinkedList encoding.
+
+func (d *DoublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.ToSlice())
+}
+
+func (d *DoublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONValues[T](data)
+	if err == nil && values != nil {
+		d.replaceAll(values)
+	}
+	return err
+}
+
+func (d *DoublyLinkedList[T]) MarshalText() ([]byte, error) {
+	return d.MarshalJSON()
+}
+
+func (d *DoublyLinkedList[T]) UnmarshalText(text []byte) error {
+	return d.UnmarshalJSON(text)
+}
+
+func (d *DoublyLinkedList[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(d.ToSlice())
+}
+
+func (d *DoublyLinkedList[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		d.replaceAll(values)
+	}
+	return err
+}
+
+// replaceAll removes all the elements of the list and appends the given values.
+func (d *DoublyLinkedList[T]) replaceAll(values []T) {
+	d.Clear()
+	d.AddAll(slices.Values(values))
+}
+
+// CircularLinkedList encoding.
+
+func (c *CircularLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.ToSlice())
+}
+
+func (c *CircularLinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONValues[T](data)
+	if err == nil && values != nil {
+		c.replaceAll(values)
+	}
+	return err
+}
+
+func (c *CircularLinkedList[T]) MarshalText() ([]byte, error) {
+	return c.MarshalJSON()
+}
+
+func (c *CircularLinkedList[T]) UnmarshalText(text []byte) error {
+	return c.UnmarshalJSON(text)
+}
+
+func (c *CircularLinkedList[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(c.ToSlice())
+}
+
+func (c *CircularLinkedList[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		c.replaceAll(values)
+	}
+	return err
+}
+
+// replaceAll removes all the elements of the list and appends the given values.
+func (c *CircularLinkedList[T]) replaceAll(values []T) {
+	c.Clear()
+	c.AddAll(slices.Values(values))
+}
+
+// SinglyLinkedNode encoding, the node and all the nodes linked after it are encoded.
+
+func (s *SinglyLinkedNode[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.values())
+}
+
+// UnmarshalJSON makes the receiver the head of the decoded nodes.
+// An empty array decodes to a single node holding the zero value of T, like [AsSinglyLinkedNodes].
+func (s *SinglyLinkedNode[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONValues[T](data)
+	if err == nil && values != nil {
+		*s = *AsSinglyLinkedNodes(values...)
+	}
+	return err
+}
+
+func (s *SinglyLinkedNode[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+func (s *SinglyLinkedNode[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+func (s *SinglyLinkedNode[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(s.values())
+}
+
+func (s *SinglyLinkedNode[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		*s = *AsSinglyLinkedNodes(values...)
+	}
+	return err
+}
+
+// values returns the values of s and of all the nodes linked after it.
+func (s *SinglyLinkedNode[T]) values() []T {
+	if s == nil {
+		return nil
+	}
+	values := make([]T, 0, s.Len())
+	for cur := s; cur != nil; cur = cur.Next {
+		values = append(values, cur.Value)
+	}
+	return values
+}
+
+// DoublyLinkedNode encoding, the node and all the nodes linked after it are encoded.
+
+func (d *DoublyLinkedNode[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.values())
+}
+
+// UnmarshalJSON makes the receiver the head of the decoded nodes.
+// An empty array decodes to a single node holding the zero value of T, like [AsDoubleLinkedNodes].
+func (d *DoublyLinkedNode[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONValues[T](data)
+	if err == nil && values != nil {
+		d.setValues(values)
+	}
+	return err
+}
+
+func (d *DoublyLinkedNode[T]) MarshalText() ([]byte, error) {
+	return d.MarshalJSON()
+}
+
+func (d *DoublyLinkedNode[T]) UnmarshalText(text []byte) error {
+	return d.UnmarshalJSON(text)
+}
+
+func (d *DoublyLinkedNode[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(d.values())
+}
+
+func (d *DoublyLinkedNode[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		d.setValues(values)
+	}
+	return err
+}
+
+// values returns the values of d and of all the nodes linked after it.
+func (d *DoublyLinkedNode[T]) values() []T {
+	if d == nil {
+		return nil
+	}
+	values := make([]T, 0, d.Len())
+	for cur := d; cur != nil; cur = cur.Next {
+		values = append(values, cur.Value)
+	}
+	return values
+}
+
+// setValues makes d the head of new nodes holding the given values.
+func (d *DoublyLinkedNode[T]) setValues(values []T) {
+	*d = *AsDoubleLinkedNodes(values...)
+	if d.Next != nil {
+		d.Next.Prev = d // the second node still points to the copied head
+	}
+}
+
+// unmarshalJSONValues decodes a JSON array into a slice.
+// It returns a nil slice for JSON null, which by convention leaves the receiver unchanged.
+func unmarshalJSONValues[T any](data []byte) ([]T, error) {
+	var values []T
+	err := json.Unmarshal(data, &values)
+	return values, err
+}
+
+func gobEncodeValues[T any](values []T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecodeValues[T any](data []byte) ([]T, error) {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
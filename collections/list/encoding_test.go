@@ -0,0 +1,203 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// encodableLists returns an empty list of every implementation, as encoding needs the concrete types.
+func encodableLists[T any]() map[string]LinkedList[T] {
+	return map[string]LinkedList[T]{
+		SinglyLinked.String(): &SinglyLinkedList[T]{},
+		DoublyLinked.String(): &DoublyLinkedList[T]{},
+		Circular.String():     &CircularLinkedList[T]{},
+	}
+}
+
+func TestLinkedList_JSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		json   string
+	}{
+		{"empty", []string{}, `[]`},
+		{"one element", []string{"a"}, `["a"]`},
+		{"many elements", []string{"a", "b", "c"}, `["a","b","c"]`},
+	}
+	for name, list := range encodableLists[string]() {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				for _, v := range tt.values {
+					list.AddLast(v)
+				}
+				got, err := json.Marshal(list)
+				if err != nil || string(got) != tt.json {
+					t.Fatalf("json.Marshal() = %s, %v, want %s, nil", got, err, tt.json)
+				}
+				decoded := encodableLists[string]()[name]
+				decoded.AddLast("to be replaced")
+				if err := json.Unmarshal(got, decoded); err != nil {
+					t.Fatalf("json.Unmarshal() error = %v", err)
+				}
+				checkEnds(t, decoded, tt.values)
+				// leave the list empty for the next test case
+				for !list.IsEmpty() {
+					list.RemoveFirst()
+				}
+			})
+		}
+	}
+}
+
+func TestLinkedList_JSONField(t *testing.T) {
+	type payload struct {
+		Name  string                   `json:"name"`
+		Items *DoublyLinkedList[int]   `json:"items"`
+		Ring  *CircularLinkedList[int] `json:"ring"`
+		Stack *SinglyLinkedList[int]   `json:"stack,omitempty"`
+	}
+	in := payload{
+		Name:  "p",
+		Items: NewLinkedListFrom(DoublyLinked, 1, 2).(*DoublyLinkedList[int]),
+		Ring:  NewLinkedListFrom(Circular, 3).(*CircularLinkedList[int]),
+	}
+	data, err := json.Marshal(in)
+	want := `{"name":"p","items":[1,2],"ring":[3]}`
+	if err != nil || string(data) != want {
+		t.Fatalf("json.Marshal() = %s, %v, want %s, nil", data, err, want)
+	}
+	var out payload
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	checkEnds[int](t, out.Items, []int{1, 2})
+	checkEnds[int](t, out.Ring, []int{3})
+	if out.Stack != nil {
+		t.Errorf("Stack = %v, want nil", out.Stack)
+	}
+}
+
+func TestLinkedList_JSONErrors(t *testing.T) {
+	for name, list := range encodableLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			list.AddLast(1)
+			for _, bad := range []string{`{"a":1}`, `["a"]`, `[1,`} {
+				if err := json.Unmarshal([]byte(bad), list); err == nil {
+					t.Errorf("json.Unmarshal(%s) error = nil, want error", bad)
+				}
+			}
+			// null leaves the list unchanged
+			if err := json.Unmarshal([]byte(`null`), list); err != nil {
+				t.Errorf("json.Unmarshal(null) error = %v", err)
+			}
+			checkEnds(t, list, []int{1})
+		})
+	}
+}
+
+func TestLinkedList_Text(t *testing.T) {
+	for name, list := range encodableLists[float64]() {
+		t.Run(name, func(t *testing.T) {
+			list.AddLast(1.5).AddLast(-2)
+			text, err := list.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+			if err != nil || string(text) != `[1.5,-2]` {
+				t.Fatalf("MarshalText() = %s, %v, want %s, nil", text, err, `[1.5,-2]`)
+			}
+			decoded := encodableLists[float64]()[name]
+			if err := decoded.(interface{ UnmarshalText([]byte) error }).UnmarshalText([]byte(`[3, 4.25]`)); err != nil {
+				t.Fatalf("UnmarshalText() error = %v", err)
+			}
+			checkEnds(t, decoded, []float64{3, 4.25})
+		})
+	}
+}
+
+func TestLinkedList_Gob(t *testing.T) {
+	type point struct{ X, Y int }
+	for name, list := range encodableLists[point]() {
+		t.Run(name, func(t *testing.T) {
+			list.AddLast(point{1, 2}).AddLast(point{3, 4})
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(list); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			decoded := encodableLists[point]()[name]
+			if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			checkEnds(t, decoded, []point{{1, 2}, {3, 4}})
+		})
+	}
+}
+
+func TestSinglyLinkedNode_Encoding(t *testing.T) {
+	head := AsSinglyLinkedNodes("x", "y", "z")
+	data, err := json.Marshal(head)
+	if err != nil || string(data) != `["x","y","z"]` {
+		t.Fatalf("json.Marshal() = %s, %v, want %s, nil", data, err, `["x","y","z"]`)
+	}
+	var decoded SinglyLinkedNode[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded.values(), []string{"x", "y", "z"}) {
+		t.Errorf("decoded = %v, want %v", decoded.String(), head.String())
+	}
+
+	var nilNode *SinglyLinkedNode[string]
+	if data, _ := json.Marshal(nilNode); string(data) != "null" {
+		t.Errorf("json.Marshal(nil) = %s, want null", data)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(head); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var fromGob SinglyLinkedNode[string]
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if fromGob.String() != head.String() {
+		t.Errorf("gob decoded = %v, want %v", fromGob.String(), head.String())
+	}
+	if text, _ := head.MarshalText(); string(text) != `["x","y","z"]` {
+		t.Errorf("MarshalText() = %s, want %s", text, `["x","y","z"]`)
+	}
+}
+
+func TestDoublyLinkedNode_Encoding(t *testing.T) {
+	var decoded DoublyLinkedNode[int]
+	if err := json.Unmarshal([]byte(`[1,2,3]`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if decoded.Len() != 3 || decoded.Next.Prev != &decoded || decoded.Next.Next.Prev != decoded.Next || decoded.Prev != nil {
+		t.Errorf("decoded = %v, prev links are broken", decoded.String())
+	}
+	data, err := json.Marshal(&decoded)
+	if err != nil || string(data) != `[1,2,3]` {
+		t.Errorf("json.Marshal() = %s, %v, want %s, nil", data, err, `[1,2,3]`)
+	}
+	// marshalling starts from the receiver
+	if data, _ := json.Marshal(decoded.Next); string(data) != `[2,3]` {
+		t.Errorf("json.Marshal(second) = %s, want %s", data, `[2,3]`)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&decoded); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var fromGob DoublyLinkedNode[int]
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if fromGob.String() != decoded.String() || fromGob.Next.Prev != &fromGob {
+		t.Errorf("gob decoded = %v, want %v", fromGob.String(), decoded.String())
+	}
+	var fromText DoublyLinkedNode[int]
+	if err := fromText.UnmarshalText([]byte(`[7]`)); err != nil || fromText.Value != 7 || fromText.Next != nil {
+		t.Errorf("UnmarshalText() = %v, %v, want [7]", fromText.String(), err)
+	}
+}
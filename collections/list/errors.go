@@ -3,7 +3,11 @@ package list
 import "fmt"
 
 // sentinelError is a type of error which indicates a state of the list.
-// Ex - Index out boundary (ErrIndexOutOfBounds), No such elements (ErrNoSuchElement)
+// Ex - Index out boundary (ErrIndexOutOfBounds), No such elements (ErrNoSuchElement),
+// Operation not allowed in the current state (ErrIllegalState),
+// List structurally modified while being iterated (ErrConcurrentModification),
+// Handle of an element that is not in the list (ErrInvalidHandle),
+// Argument not allowed for the operation (ErrIllegalArgument)
 type sentinelError string
 
 func (e sentinelError) Error() string {
@@ -11,8 +15,12 @@ func (e sentinelError) Error() string {
 }
 
 const (
-	ErrNoSuchElement    sentinelError = "ErrNoSuchElement"
-	ErrIndexOutOfBounds sentinelError = "ErrIndexOutOfBounds"
+	ErrNoSuchElement          sentinelError = "ErrNoSuchElement"
+	ErrIndexOutOfBounds       sentinelError = "ErrIndexOutOfBounds"
+	ErrIllegalState           sentinelError = "ErrIllegalState"
+	ErrConcurrentModification sentinelError = "ErrConcurrentModification"
+	ErrInvalidHandle          sentinelError = "ErrInvalidHandle"
+	ErrIllegalArgument        sentinelError = "ErrIllegalArgument"
 )
 
 func errIndexOutOfBounds(index, len int) error {
@@ -22,3 +30,27 @@ func errIndexOutOfBounds(index, len int) error {
 func errNoSuchElement() error {
 	return fmt.Errorf("%w: empty collection", ErrNoSuchElement)
 }
+
+func errNoCurrentElement() error {
+	return fmt.Errorf("%w: no current element, call Next or Prev first", ErrIllegalState)
+}
+
+func errConcurrentModification() error {
+	return fmt.Errorf("%w: list was structurally modified during iteration", ErrConcurrentModification)
+}
+
+func errInvalidHandle() error {
+	return fmt.Errorf("%w: element does not belong to this list or was removed", ErrInvalidHandle)
+}
+
+func errSpliceIntoItself() error {
+	return fmt.Errorf("%w: a list cannot be spliced into itself", ErrIllegalArgument)
+}
+
+func errUnknownCheckpoint(name string) error {
+	return fmt.Errorf("%w: no checkpoint named %q", ErrIllegalArgument, name)
+}
+
+func errInTransaction() error {
+	return fmt.Errorf("%w: not allowed during a transaction", ErrIllegalState)
+}
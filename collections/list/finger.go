@@ -0,0 +1,98 @@
+package list
+
+import "sync/atomic"
+
+// finger caches the last node of a DoublyLinkedList or a CircularLinkedList found by index,
+// so that finding the same or a nearby index again, as in a loop over Get(i), walks only a few nodes.
+// It is valid only while the list is not structurally modified after it was set,
+// which is checked against the modification count of the list.
+//
+// Get moves the finger, and lists may be read by several goroutines at once (see [SynchronizedList]),
+// so the position is guarded by a sequence number, odd while the position is being set:
+// a reader ignores a position whose sequence number changed while it was read, and a goroutine
+// finding the finger already being moved by another one leaves it alone. Setting the finger does not allocate.
+type finger[T any] struct {
+	seq      atomic.Uint64
+	node     atomic.Pointer[doublyLinkedNode[T]]
+	index    atomic.Int64
+	modCount atomic.Int64
+	disabled bool // see SetFinger
+}
+
+// set points the finger to the node at the index of a list whose modification count is modCount.
+func (f *finger[T]) set(node *doublyLinkedNode[T], index, modCount int) {
+	if f.disabled {
+		return
+	}
+	seq := f.seq.Load()
+	if seq%2 == 1 || !f.seq.CompareAndSwap(seq, seq+1) {
+		// another goroutine is moving the finger, its position is as good as this one
+		return
+	}
+	f.node.Store(node)
+	f.index.Store(int64(index))
+	f.modCount.Store(int64(modCount))
+	f.seq.Store(seq + 2)
+}
+
+// get returns the node and the index of the finger, if it is set on a list whose modification count is modCount.
+func (f *finger[T]) get(modCount int) (*doublyLinkedNode[T], int, bool) {
+	if f.disabled {
+		return nil, 0, false
+	}
+	seq := f.seq.Load()
+	if seq%2 == 1 {
+		return nil, 0, false
+	}
+	node, index, valid := f.node.Load(), int(f.index.Load()), f.modCount.Load() == int64(modCount)
+	if f.seq.Load() != seq || node == nil || !valid {
+		return nil, 0, false
+	}
+	return node, index, true
+}
+
+// nodeAt returns the node at the valid index of a list with the given length, ends and modification count,
+// walking from the nearest of the head, the tail and the finger, and points the finger to it.
+func (f *finger[T]) nodeAt(index, len, modCount int, head, tail *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	cur, at := head, 0
+	if len-1-index < index {
+		cur, at = tail, len-1
+	}
+	if node, i, ok := f.get(modCount); ok {
+		if i == index {
+			return node
+		}
+		if distance(index, i) < distance(index, at) {
+			cur, at = node, i
+		}
+	}
+	for ; at < index; at++ {
+		cur = cur.next
+	}
+	for ; at > index; at-- {
+		cur = cur.prev
+	}
+	f.set(cur, index, modCount)
+	return cur
+}
+
+func distance(i, j int) int {
+	if i < j {
+		return j - i
+	}
+	return i - j
+}
+
+// SetFinger turns on or off the cache of the last node found by index, which is on by default.
+// With the cache, accessing an index next to the last one accessed, as in a loop over Get(i), walks a single node;
+// without it, Get, Insert and RemoveAt always walk from the nearer end of the list.
+func (d *DoublyLinkedList[T]) SetFinger(enabled bool) {
+	d.finger.disabled = !enabled
+}
+
+// SetFinger turns on or off the cache of the last node found by index, which is on by default.
+// With the cache, accessing an index next to the last one accessed, as in a loop over Get(i), walks a single node;
+// without it, Get, Insert and RemoveAt always walk from the nearer end of the list.
+func (c *CircularLinkedList[T]) SetFinger(enabled bool) {
+	c.finger.disabled = !enabled
+}
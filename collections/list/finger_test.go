@@ -0,0 +1,167 @@
+package list
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLinkedList_FingerInvalidation(t *testing.T) {
+	for name, newList := range handleLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			l := newList()
+			for i := range 10 {
+				l.AddLast(i)
+			}
+			steps := []struct {
+				name   string
+				modify func()
+				want   []int
+			}{
+				{"RemoveFirst", func() { l.RemoveFirst() }, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+				{"AddFirst", func() { l.AddFirst(0) }, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+				{"Insert", func() { l.Insert(-1, 4) }, []int{0, 1, 2, 3, -1, 4, 5, 6, 7, 8, 9}},
+				{"RemoveAt", func() { l.RemoveAt(4) }, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+				{"Reverse", func() { l.(reorderList[int]).Reverse() }, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}},
+				{"Rotate", func() { l.(reorderList[int]).Rotate(3) }, []int{2, 1, 0, 9, 8, 7, 6, 5, 4, 3}},
+				{"Swap", func() { l.(reorderList[int]).Swap(4, 5) }, []int{2, 1, 0, 9, 7, 8, 6, 5, 4, 3}},
+				{"MoveToFront", func() {
+					h, _ := l.LastHandle()
+					l.MoveToFront(h)
+				}, []int{3, 2, 1, 0, 9, 7, 8, 6, 5, 4}},
+			}
+			for _, step := range steps {
+				// point the finger in the middle of the list before modifying it
+				l.Get(5)
+				step.modify()
+				// sequential and backward accesses, which reuse the finger
+				for i := range step.want {
+					if got, _ := l.Get(i); got != step.want[i] {
+						t.Fatalf("after %v, Get(%v) = %v, want %v", step.name, i, got, step.want[i])
+					}
+				}
+				for i := len(step.want) - 1; i >= 0; i -= 3 {
+					if got, _ := l.Get(i); got != step.want[i] {
+						t.Fatalf("after %v, Get(%v) = %v, want %v", step.name, i, got, step.want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLinkedList_SequentialInsertRemoveAt(t *testing.T) {
+	for name, newList := range handleLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			l := newList()
+			l.AddLast(-1).AddLast(-2)
+			for i := range 6 {
+				l.Insert(i, i+1)
+			}
+			checkEnds(t, l, []int{-1, 0, 1, 2, 3, 4, 5, -2})
+			for i := 1; i < l.Len()-1; i++ {
+				if v, _ := l.RemoveAt(i); v != 2*i-2 {
+					t.Errorf("RemoveAt(%v) = %v, want %v", i, v, 2*i-2)
+				}
+			}
+			checkEnds(t, l, []int{-1, 1, 3, 5, -2})
+		})
+	}
+}
+
+func TestLinkedList_SetFinger(t *testing.T) {
+	for name, newList := range handleLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			l := newList()
+			for i := range 100 {
+				l.AddLast(i)
+			}
+			for _, enabled := range []bool{false, true, false} {
+				l.(fingerList).SetFinger(enabled)
+				for _, i := range []int{40, 41, 40, 60, 99, 0} {
+					if got, _ := l.Get(i); got != i {
+						t.Errorf("finger %v: Get(%v) = %v, want %v", enabled, i, got, i)
+					}
+				}
+				// Get is the hottest read path, moving the finger must not allocate
+				i := 0
+				if allocs := testing.AllocsPerRun(100, func() { l.Get(i % 100); i++ }); allocs != 0 {
+					t.Errorf("finger %v: Get() allocates %v times per call, want 0", enabled, allocs)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkListLen is the length of the lists of the index access benchmarks.
+const benchmarkListLen = 1_000_000
+
+// fingerList is implemented by the lists with a finger, so the benchmarks can compare with and without it.
+type fingerList interface {
+	handleList[int]
+	SetFinger(enabled bool)
+}
+
+func fingerLists() map[string]fingerList {
+	d, c := &DoublyLinkedList[int]{}, &CircularLinkedList[int]{}
+	for i := range benchmarkListLen {
+		d.AddLast(i)
+		c.AddLast(i)
+	}
+	return map[string]fingerList{DoublyLinked.String(): d, Circular.String(): c}
+}
+
+// BenchmarkLinkedList_GetSequential measures Get(i) in a loop over the indexes of a 1M element list.
+// Without the finger each Get walks up to Len()/2 nodes from the nearer end, with it the walk is a single node.
+// Neither variant allocates.
+func BenchmarkLinkedList_GetSequential(b *testing.B) {
+	for name, l := range fingerLists() {
+		for _, useFinger := range []bool{true, false} {
+			b.Run(fmt.Sprintf("%v/finger=%v", name, useFinger), func(b *testing.B) {
+				l.SetFinger(useFinger)
+				defer l.SetFinger(true)
+				b.ReportAllocs()
+				for i := 0; b.Loop(); i++ {
+					l.Get(i % benchmarkListLen)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkLinkedList_InsertRemoveAtSequential measures Insert(e, i) then RemoveAt(i) moving forward
+// through a 1M element list, as when editing a list in place by index.
+func BenchmarkLinkedList_InsertRemoveAtSequential(b *testing.B) {
+	for name, l := range fingerLists() {
+		for _, useFinger := range []bool{true, false} {
+			b.Run(fmt.Sprintf("%v/finger=%v", name, useFinger), func(b *testing.B) {
+				l.SetFinger(useFinger)
+				defer l.SetFinger(true)
+				b.ReportAllocs()
+				for i := 0; b.Loop(); i++ {
+					index := i % benchmarkListLen
+					l.Insert(-1, index)
+					l.RemoveAt(index)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkLinkedList_GetNearTail measures Get of an index near the tail of a 1M element list.
+// Both variants walk from the tail rather than from the head, which nodeAt did before the finger was added,
+// so the baseline without the finger is that walk: the difference is only what the finger saves
+// when the same index is read again.
+func BenchmarkLinkedList_GetNearTail(b *testing.B) {
+	for name, l := range fingerLists() {
+		for _, useFinger := range []bool{true, false} {
+			b.Run(fmt.Sprintf("%v/finger=%v", name, useFinger), func(b *testing.B) {
+				l.SetFinger(useFinger)
+				defer l.SetFinger(true)
+				b.ReportAllocs()
+				for b.Loop() {
+					l.Get(benchmarkListLen - 10)
+				}
+			})
+		}
+	}
+}
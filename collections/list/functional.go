@@ -0,0 +1,175 @@
+package list
+
+import "iter"
+
+// Functional operations on LinkedList.
+//
+// The list functions accept any LinkedList and return new lists of the same linkedListType as the given list
+// (see [NewLinkedList]); the given list is not modified. The wrappers ([SynchronizedList], [ObservableList]
+// and [Journal]) yield lists of the type they wrap, and an implementation unknown to this package yields
+// a [DoublyLinkedList].
+//
+// The Seq functions are their lazy counterparts for pipelines: they transform an [iter.Seq],
+// such as the one returned by [LinkedList.Values], and call the given functions only as the result is iterated.
+// Ex:
+//
+//	squares := Map(numbers, func(n int) int { return n * n })
+//	for s := range MapSeq(FilterSeq(numbers.Values(), isEven), strconv.Itoa) { ... }
+
+// Map returns a new list holding the result of f applied to each element of l, in order.
+func Map[T, U any](l LinkedList[T], f func(T) U) LinkedList[U] {
+	return collect(typeOf(l), MapSeq(l.Values(), f))
+}
+
+// Filter returns a new list holding the elements of l for which keep returns true, in order.
+func Filter[T any](l LinkedList[T], keep func(T) bool) LinkedList[T] {
+	return collect(typeOf(l), FilterSeq(l.Values(), keep))
+}
+
+// Reduce combines the elements of l from the head to the tail, starting with initial:
+// the result is f(...f(f(initial, l[0]), l[1])..., l[n-1]). It returns initial if l is empty.
+func Reduce[T, A any](l LinkedList[T], initial A, f func(A, T) A) A {
+	return ReduceSeq(l.Values(), initial, f)
+}
+
+// FlatMap returns a new list holding, in order, the elements of the sequences returned by f for each element of l.
+func FlatMap[T, U any](l LinkedList[T], f func(T) iter.Seq[U]) LinkedList[U] {
+	return collect(typeOf(l), FlatMapSeq(l.Values(), f))
+}
+
+// TakeWhile returns a new list holding the leading elements of l for which keep returns true,
+// up to (excluding) the first element for which it returns false.
+func TakeWhile[T any](l LinkedList[T], keep func(T) bool) LinkedList[T] {
+	return collect(typeOf(l), TakeWhileSeq(l.Values(), keep))
+}
+
+// DropWhile returns a new list holding the elements of l starting from the first element for which drop returns false.
+func DropWhile[T any](l LinkedList[T], drop func(T) bool) LinkedList[T] {
+	return collect(typeOf(l), DropWhileSeq(l.Values(), drop))
+}
+
+// Partition returns two new lists: the elements of l for which pred returns true, and the others, both in order.
+func Partition[T any](l LinkedList[T], pred func(T) bool) (matched, rest LinkedList[T]) {
+	matched, rest = NewLinkedList[T](typeOf(l)), NewLinkedList[T](typeOf(l))
+	for v := range l.Values() {
+		if pred(v) {
+			matched.AddLast(v)
+		} else {
+			rest.AddLast(v)
+		}
+	}
+	return
+}
+
+// GroupBy returns a map from every key returned by key for the elements of l
+// to a new list holding, in order, the elements of that key.
+func GroupBy[T any, K comparable](l LinkedList[T], key func(T) K) map[K]LinkedList[T] {
+	groups := make(map[K]LinkedList[T])
+	for v := range l.Values() {
+		k := key(v)
+		group, ok := groups[k]
+		if !ok {
+			group = NewLinkedList[T](typeOf(l))
+			groups[k] = group
+		}
+		group.AddLast(v)
+	}
+	return groups
+}
+
+// MapSeq returns a sequence of the result of f applied to each element of seq.
+func MapSeq[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns a sequence of the elements of seq for which keep returns true.
+func FilterSeq[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq combines the elements of seq in order, starting with initial. It returns initial if seq is empty.
+// Unlike the other Seq functions, it consumes seq immediately.
+func ReduceSeq[T, A any](seq iter.Seq[T], initial A, f func(A, T) A) A {
+	acc := initial
+	for v := range seq {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// FlatMapSeq returns the concatenation of the sequences returned by f for each element of seq.
+func FlatMapSeq[T, U any](seq iter.Seq[T], f func(T) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			for u := range f(v) {
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhileSeq returns a sequence of the leading elements of seq for which keep returns true.
+// It stops at the first element for which keep returns false.
+func TakeWhileSeq[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !keep(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhileSeq returns a sequence of the elements of seq starting from the first element for which drop returns false.
+func DropWhileSeq[T any](seq iter.Seq[T], drop func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && drop(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// typedList is implemented by the lists of this package to report their linkedListType,
+// so that the functions returning a new list return one of the same type as their argument.
+// Wrappers such as [SynchronizedList] and views such as [ListView] report the type of the list they are backed by.
+type typedList interface {
+	listType() linkedListType
+}
+
+// typeOf returns the linkedListType of the given list, [DoublyLinked] for an implementation outside of this package.
+func typeOf[T any](l LinkedList[T]) linkedListType {
+	if t, ok := l.(typedList); ok {
+		return t.listType()
+	}
+	return DoublyLinked
+}
+
+// collect returns a new list of the given type holding the elements of seq.
+func collect[T any](lt linkedListType, seq iter.Seq[T]) LinkedList[T] {
+	l := NewLinkedList[T](lt)
+	for v := range seq {
+		l.AddLast(v)
+	}
+	return l
+}
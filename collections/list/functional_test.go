@@ -0,0 +1,171 @@
+package list
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func isEven(n int) bool { return n%2 == 0 }
+
+func TestMapFilterFlatMap(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 1, 2, 3, 4)
+			strs := Map(l, strconv.Itoa)
+			checkEnds(t, strs, []string{"1", "2", "3", "4"})
+			evens := Filter(l, isEven)
+			checkEnds(t, evens, []int{2, 4})
+			repeated := FlatMap(l, func(n int) iter.Seq[int] {
+				return slices.Values(slices.Repeat([]int{n}, n-1))
+			})
+			checkEnds(t, repeated, []int{2, 3, 3, 4, 4, 4})
+			for name, got := range map[string]linkedListType{
+				"Map": typeOf(strs), "Filter": typeOf(evens), "FlatMap": typeOf(repeated),
+			} {
+				if got != lt {
+					t.Errorf("%v() type = %v, want %v", name, got, lt)
+				}
+			}
+			// the source list is left unchanged
+			checkEnds(t, l, []int{1, 2, 3, 4})
+			checkEnds(t, Map(NewLinkedList[int](lt), strconv.Itoa), []string{})
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   string
+	}{
+		{"empty", nil, "#"},
+		{"one element", []int{1}, "#1"},
+		{"many elements", []int{1, 2, 3}, "#123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLinkedListFromSlice(SinglyLinked, tt.values)
+			got := Reduce(l, "#", func(acc string, n int) string { return acc + strconv.Itoa(n) })
+			if got != tt.want {
+				t.Errorf("Reduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTakeWhileDropWhile(t *testing.T) {
+	lessThan3 := func(n int) bool { return n < 3 }
+	tests := []struct {
+		name     string
+		values   []int
+		take     []int
+		dropRest []int
+	}{
+		{"empty", []int{}, []int{}, []int{}},
+		{"all match", []int{1, 2}, []int{1, 2}, []int{}},
+		{"none match", []int{3, 1}, []int{}, []int{3, 1}},
+		{"prefix match", []int{1, 2, 3, 1, 4}, []int{1, 2}, []int{3, 1, 4}},
+	}
+	for _, lt := range allLinkedListTypes {
+		for _, tt := range tests {
+			t.Run(lt.String()+"/"+tt.name, func(t *testing.T) {
+				l := NewLinkedListFromSlice(lt, tt.values)
+				checkEnds(t, TakeWhile(l, lessThan3), tt.take)
+				checkEnds(t, DropWhile(l, lessThan3), tt.dropRest)
+			})
+		}
+	}
+}
+
+func TestPartitionGroupBy(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 5, 2, 7, 4, 4)
+			evens, odds := Partition(l, isEven)
+			checkEnds(t, evens, []int{2, 4, 4})
+			checkEnds(t, odds, []int{5, 7})
+			if typeOf(evens) != lt || typeOf(odds) != lt {
+				t.Errorf("Partition() types = %v, %v, want %v", typeOf(evens), typeOf(odds), lt)
+			}
+
+			groups := GroupBy(l, func(n int) int { return n % 3 })
+			want := map[int][]int{2: {5, 2}, 1: {7, 4, 4}}
+			got := make(map[int][]int)
+			for k, group := range groups {
+				got[k] = group.ToSlice()
+				if typeOf(group) != lt {
+					t.Errorf("GroupBy() group type = %v, want %v", typeOf(group), lt)
+				}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("GroupBy() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	if got := typeOf[int](Synchronized(NewLinkedList[int](Circular))); got != Circular {
+		t.Errorf("typeOf(Synchronized(Circular)) = %v, want %v", got, Circular)
+	}
+	if got := typeOf(Filter[int](Synchronized[int](nil), isEven)); got != DoublyLinked {
+		t.Errorf("typeOf(Filter(Synchronized(nil))) = %v, want %v", got, DoublyLinked)
+	}
+	circular := NewLinkedListFrom(Circular, 1, 2, 3, 4).(*CircularLinkedList[int])
+	view, _ := circular.SubList(1, 3)
+	nested, _ := view.SubList(0, 1)
+	tests := []struct {
+		name string
+		list LinkedList[int]
+		want linkedListType
+	}{
+		{"SubList", view, Circular},
+		{"nested SubList", nested, Circular},
+		{"Filter of a SubList", Filter[int](view, isEven), Circular},
+		{"Observable", Observable(NewLinkedList[int](SinglyLinked)), SinglyLinked},
+		{"Journal", NewJournal(NewLinkedList[int](Unrolled)), Unrolled},
+		{"Journal of Observable", NewJournal[int](Observable(NewLinkedList[int](Circular))), Circular},
+	}
+	for _, tt := range tests {
+		if got := typeOf(tt.list); got != tt.want {
+			t.Errorf("typeOf(%v) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSeqFunctionsAreLazy(t *testing.T) {
+	calls := 0
+	double := func(n int) int {
+		calls++
+		return n * 2
+	}
+	l := NewLinkedListFrom(DoublyLinked, 1, 2, 3, 4, 5, 6)
+	seq := MapSeq(FilterSeq(l.Values(), isEven), double)
+	if calls != 0 {
+		t.Fatalf("MapSeq() called f %v times before iteration, want 0", calls)
+	}
+	var got []int
+	for v := range seq {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{4, 8}) || calls != 2 {
+		t.Errorf("MapSeq(FilterSeq()) = %v with %v calls, want [4 8] with 2 calls", got, calls)
+	}
+
+	pipeline := TakeWhileSeq(DropWhileSeq(FlatMapSeq(l.Values(), func(n int) iter.Seq[int] {
+		return slices.Values([]int{n, -n})
+	}), func(n int) bool { return n < 3 }), func(n int) bool { return n != 5 })
+	if got := slices.Collect(pipeline); !slices.Equal(got, []int{3, -3, 4, -4}) {
+		t.Errorf("pipeline = %v, want [3 -3 4 -4]", got)
+	}
+	if got := ReduceSeq(slices.Values([]int{1, 2, 3}), 10, func(a, n int) int { return a - n }); got != 4 {
+		t.Errorf("ReduceSeq() = %v, want 4", got)
+	}
+}
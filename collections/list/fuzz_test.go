@@ -0,0 +1,188 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Operations decoded from the fuzz input, one per pair of bytes: the first byte selects the operation,
+// the second one is its argument, the element to add or the index.
+const (
+	fuzzAddFirst = iota
+	fuzzAddLast
+	fuzzInsert
+	fuzzRemoveAt
+	fuzzGet
+	fuzzOperations
+)
+
+// fuzzSeeds are inputs exercising the middle-of-list paths of Insert, RemoveAt and Get.
+var fuzzSeeds = [][]byte{
+	{},
+	{fuzzAddLast, 1, fuzzAddLast, 2, fuzzAddLast, 3, fuzzInsert, 3, fuzzGet, 2},
+	{fuzzAddFirst, 1, fuzzAddFirst, 2, fuzzAddFirst, 3, fuzzAddFirst, 4, fuzzInsert, 2, fuzzInsert, 3, fuzzGet, 3},
+	{fuzzAddLast, 1, fuzzAddLast, 2, fuzzAddLast, 3, fuzzAddLast, 4, fuzzRemoveAt, 3, fuzzRemoveAt, 2, fuzzGet, 1},
+	{fuzzInsert, 0, fuzzInsert, 1, fuzzInsert, 5, fuzzRemoveAt, 0, fuzzRemoveAt, 0, fuzzGet, 0},
+}
+
+func FuzzSinglyLinkedList(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		s := &SinglyLinkedList[byte]{}
+		fuzzLinkedList(t, s, ops, func(want []byte) {
+			checkSinglyLinks(t, s, want)
+		})
+	})
+}
+
+func FuzzDoublyLinkedList(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		d := &DoublyLinkedList[byte]{}
+		fuzzLinkedList(t, d, ops, func(want []byte) {
+			checkDoublyLinks(t, d.head, d.tail, want, false)
+		})
+	})
+}
+
+func FuzzCircularLinkedList(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		c := &CircularLinkedList[byte]{}
+		fuzzLinkedList(t, c, ops, func(want []byte) {
+			checkDoublyLinks(t, c.head, c.tail, want, true)
+		})
+	})
+}
+
+func FuzzUnrolledLinkedList(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		u := &UnrolledLinkedList[byte]{}
+		fuzzLinkedList(t, u, ops, func(want []byte) {
+			checkUnrolledNodes(t, u, want)
+		})
+	})
+}
+
+// fuzzLinkedList applies the operations decoded from ops to the list and to a reference slice,
+// and checks after every operation that both agree and that checkLinks holds.
+// Indexes range from -1 to len+1, so out of bounds indexes are exercised as well.
+func fuzzLinkedList(t *testing.T, l LinkedList[byte], ops []byte, checkLinks func(want []byte)) {
+	var want []byte
+	for i := 0; i+1 < len(ops); i += 2 {
+		op, arg := ops[i]%fuzzOperations, ops[i+1]
+		index := int(arg)%(len(want)+3) - 1
+		inBounds := index >= 0 && index < len(want)
+		switch op {
+		case fuzzAddFirst:
+			l.AddFirst(arg)
+			want = slices.Insert(want, 0, arg)
+		case fuzzAddLast:
+			l.AddLast(arg)
+			want = append(want, arg)
+		case fuzzInsert:
+			ok, err := l.Insert(arg, index)
+			if index >= 0 && index <= len(want) {
+				if !ok || err != nil {
+					t.Fatalf("op %v: Insert(%v, %v) = %v, %v, want true, nil", i/2, arg, index, ok, err)
+				}
+				want = slices.Insert(want, index, arg)
+			} else if ok || !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Fatalf("op %v: Insert(%v, %v) = %v, %v, want false, %v", i/2, arg, index, ok, err, ErrIndexOutOfBounds)
+			}
+		case fuzzRemoveAt:
+			got, err := l.RemoveAt(index)
+			if inBounds {
+				if err != nil || got != want[index] {
+					t.Fatalf("op %v: RemoveAt(%v) = %v, %v, want %v, nil", i/2, index, got, err, want[index])
+				}
+				want = slices.Delete(want, index, index+1)
+			} else if !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Fatalf("op %v: RemoveAt(%v) error = %v, want %v", i/2, index, err, ErrIndexOutOfBounds)
+			}
+		case fuzzGet:
+			got, err := l.Get(index)
+			if inBounds && (err != nil || got != want[index]) {
+				t.Fatalf("op %v: Get(%v) = %v, %v, want %v, nil", i/2, index, got, err, want[index])
+			}
+			if !inBounds && !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Fatalf("op %v: Get(%v) error = %v, want %v", i/2, index, err, ErrIndexOutOfBounds)
+			}
+		}
+
+		if got := l.ToSlice(); !slices.Equal(got, want) {
+			t.Fatalf("op %v: ToSlice() = %v, want %v", i/2, got, want)
+		}
+		if l.Len() != len(want) {
+			t.Fatalf("op %v: Len() = %v, want %v", i/2, l.Len(), len(want))
+		}
+		head, headErr := l.GetHeadNode()
+		tail, tailErr := l.GetTailNode()
+		if len(want) == 0 {
+			if headErr == nil || tailErr == nil {
+				t.Fatalf("op %v: GetHeadNode(), GetTailNode() errors = %v, %v on empty list", i/2, headErr, tailErr)
+			}
+		} else if head.Value() != want[0] || tail.Value() != want[len(want)-1] {
+			t.Fatalf("op %v: head, tail = %v, %v, want %v, %v", i/2, head.Value(), tail.Value(), want[0], want[len(want)-1])
+		}
+		checkLinks(want)
+	}
+}
+
+// checkSinglyLinks verifies that the tail is the last node reached from the head.
+func checkSinglyLinks(t *testing.T, s *SinglyLinkedList[byte], want []byte) {
+	t.Helper()
+	if len(want) == 0 {
+		if s.head != nil || s.tail != nil {
+			t.Fatalf("head, tail = %v, %v, want nil, nil on empty list", s.head, s.tail)
+		}
+		return
+	}
+	last := s.head
+	for i := 1; i < len(want); i++ {
+		last = last.next
+	}
+	if last != s.tail || s.tail.next != nil {
+		t.Fatalf("tail = %v, want the last node %v with no next node", s.tail, last)
+	}
+}
+
+// checkDoublyLinks verifies the next and prev links of the nodes from head to tail.
+// If circular, the tail must link back to the head, except in a single node list which has no links.
+func checkDoublyLinks(t *testing.T, head, tail *doublyLinkedNode[byte], want []byte, circular bool) {
+	t.Helper()
+	if len(want) == 0 {
+		if head != nil || tail != nil {
+			t.Fatalf("head, tail = %v, %v, want nil, nil on empty list", head, tail)
+		}
+		return
+	}
+	cur := head
+	for i := 1; i < len(want); i++ {
+		if cur.next.prev != cur {
+			t.Fatalf("node %v: next.prev does not point back to it", i-1)
+		}
+		cur = cur.next
+	}
+	if cur != tail {
+		t.Fatalf("node %v is not the tail", len(want)-1)
+	}
+	switch {
+	case !circular || len(want) == 1:
+		if head.prev != nil || tail.next != nil {
+			t.Fatalf("head.prev, tail.next = %v, %v, want nil, nil", head.prev, tail.next)
+		}
+	case tail.next != head || head.prev != tail:
+		t.Fatalf("tail.next, head.prev do not close the ring")
+	}
+}
@@ -0,0 +1,376 @@
+package list
+
+// nodeOwner identifies the list that a node is linked into, so that a [Handle] can be validated in O(1).
+// It is never zero-sized, as distinct zero-sized values may share the same address.
+//
+// When all the nodes of a list are moved to another list in O(1) (see [DoublyLinkedList.Splice]),
+// the owner of the moved nodes is forwarded to the owner of the destination list instead of updating every node.
+type nodeOwner struct {
+	forward *nodeOwner
+}
+
+// resolve returns the owner that o was forwarded to, or o itself if it was not forwarded.
+func (o *nodeOwner) resolve() *nodeOwner {
+	for o != nil && o.forward != nil {
+		o = o.forward
+	}
+	return o
+}
+
+// ownerOf returns the owner of the node, following the forwards, and shortens the path for the next lookup.
+func ownerOf[T any](n *doublyLinkedNode[T]) *nodeOwner {
+	n.owner = n.owner.resolve()
+	return n.owner
+}
+
+// Handle is a reference to an element of a [DoublyLinkedList] or a [CircularLinkedList].
+// It is returned by the Handle variants of the add and insert methods (ex: [DoublyLinkedList.AddLastHandle])
+// and allows removing, moving or inserting around the element in O(1), without searching for its index.
+//
+// A Handle stays valid while its element is in the list it was obtained from, even if the element is moved.
+// Once the element is removed, the methods of the list that accept the Handle return an [ErrInvalidHandle] error.
+// The zero value of Handle is never valid.
+type Handle[T any] struct {
+	node *doublyLinkedNode[T]
+}
+
+// Value returns the element referenced by the handle.
+// It returns the zero value of T for the zero Handle.
+func (h Handle[T]) Value() T {
+	n := latest(h.node)
+	if n == nil {
+		var zero T
+		return zero
+	}
+	return n.value
+}
+
+// Next returns the handle of the element following h in its list and true.
+// It returns false if h is the tail of a [DoublyLinkedList] or is not valid.
+// The tail of a [CircularLinkedList] is followed by its head, except when it is the only element:
+// a single node has no links to itself, so Next returns false.
+func (h Handle[T]) Next() (Handle[T], bool) {
+	n := latest(h.node)
+	if n == nil || n.next == nil {
+		return Handle[T]{}, false
+	}
+	return Handle[T]{n.next}, true
+}
+
+// Prev returns the handle of the element preceding h in its list and true.
+// It returns false if h is the head of a [DoublyLinkedList] or is not valid.
+// The head of a [CircularLinkedList] is preceded by its tail, except when it is the only element:
+// a single node has no links to itself, so Prev returns false.
+func (h Handle[T]) Prev() (Handle[T], bool) {
+	n := latest(h.node)
+	if n == nil || n.prev == nil {
+		return Handle[T]{}, false
+	}
+	return Handle[T]{n.prev}, true
+}
+
+// latest returns the node that n was copied to when its list stopped sharing it with a [Snapshot], if any,
+// following the copies of the successive snapshots, or n itself. See [DoublyLinkedList.Snapshot].
+func latest[T any](n *doublyLinkedNode[T]) *doublyLinkedNode[T] {
+	for n != nil && n.forward != nil {
+		n = n.forward
+	}
+	return n
+}
+
+// DoublyLinkedList handles.
+
+// AddFirstHandle adds e to the beginning of the list, like [DoublyLinkedList.AddFirst],
+// and returns the [Handle] of the new element.
+func (d *DoublyLinkedList[T]) AddFirstHandle(e T) Handle[T] {
+	// no unshare, as in AddFirst
+	return Handle[T]{d.linkAfter(e, nil)}
+}
+
+// AddLastHandle appends e to the end of the list, like [DoublyLinkedList.AddLast],
+// and returns the [Handle] of the new element.
+func (d *DoublyLinkedList[T]) AddLastHandle(e T) Handle[T] {
+	// no unshare, as in AddLast
+	return Handle[T]{d.linkBefore(e, nil)}
+}
+
+// InsertHandle inserts e at the given index, like [DoublyLinkedList.Insert], and returns the [Handle] of the new element.
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list.
+func (d *DoublyLinkedList[T]) InsertHandle(e T, index int) (Handle[T], error) {
+	if index < 0 || index > d.Len() {
+		return Handle[T]{}, errIndexOutOfBounds(index, d.Len())
+	}
+	d.unshare()
+	return Handle[T]{d.linkBefore(e, d.nodeAt(index))}, nil
+}
+
+// FirstHandle returns the [Handle] of the first element of the list.
+// If the list is empty, it returns an [ErrNoSuchElement] error.
+func (d *DoublyLinkedList[T]) FirstHandle() (Handle[T], error) {
+	if d.IsEmpty() {
+		return Handle[T]{}, errNoSuchElement()
+	}
+	return Handle[T]{d.head}, nil
+}
+
+// LastHandle returns the [Handle] of the last element of the list.
+// If the list is empty, it returns an [ErrNoSuchElement] error.
+func (d *DoublyLinkedList[T]) LastHandle() (Handle[T], error) {
+	if d.IsEmpty() {
+		return Handle[T]{}, errNoSuchElement()
+	}
+	return Handle[T]{d.tail}, nil
+}
+
+// InsertBefore inserts e immediately before the element referenced by mark and returns the [Handle] of the new element.
+// If mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (d *DoublyLinkedList[T]) InsertBefore(mark Handle[T], e T) (Handle[T], error) {
+	if !d.owns(mark) {
+		return Handle[T]{}, errInvalidHandle()
+	}
+	d.unshare()
+	return Handle[T]{d.linkBefore(e, latest(mark.node))}, nil
+}
+
+// InsertAfter inserts e immediately after the element referenced by mark and returns the [Handle] of the new element.
+// If mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (d *DoublyLinkedList[T]) InsertAfter(mark Handle[T], e T) (Handle[T], error) {
+	if !d.owns(mark) {
+		return Handle[T]{}, errInvalidHandle()
+	}
+	d.unshare()
+	return Handle[T]{d.linkAfter(e, latest(mark.node))}, nil
+}
+
+// Remove removes the element referenced by h from the list in O(1) and returns it.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (d *DoublyLinkedList[T]) Remove(h Handle[T]) (T, error) {
+	if !d.owns(h) {
+		var zero T
+		return zero, errInvalidHandle()
+	}
+	d.unshare()
+	return d.remove(latest(h.node)), nil
+}
+
+// Update replaces the element referenced by h with e.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (d *DoublyLinkedList[T]) Update(h Handle[T], e T) error {
+	if !d.owns(h) {
+		return errInvalidHandle()
+	}
+	d.unshare()
+	latest(h.node).value = e
+	return nil
+}
+
+// MoveToFront moves the element referenced by h to the beginning of the list.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (d *DoublyLinkedList[T]) MoveToFront(h Handle[T]) error {
+	if !d.owns(h) {
+		return errInvalidHandle()
+	}
+	d.unshare()
+	h.node = latest(h.node)
+	if h.node != d.head {
+		d.unlink(h.node)
+		d.linkNodeAfter(h.node, nil)
+	}
+	return nil
+}
+
+// MoveToBack moves the element referenced by h to the end of the list.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (d *DoublyLinkedList[T]) MoveToBack(h Handle[T]) error {
+	if !d.owns(h) {
+		return errInvalidHandle()
+	}
+	d.unshare()
+	h.node = latest(h.node)
+	if h.node != d.tail {
+		d.unlink(h.node)
+		d.linkNodeBefore(h.node, nil)
+	}
+	return nil
+}
+
+// MoveBefore moves the element referenced by h to the position immediately before the element referenced by mark.
+// If h or mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+// The list is not modified if h and mark reference the same element.
+func (d *DoublyLinkedList[T]) MoveBefore(h, mark Handle[T]) error {
+	if !d.owns(h) || !d.owns(mark) {
+		return errInvalidHandle()
+	}
+	d.unshare()
+	h.node, mark.node = latest(h.node), latest(mark.node)
+	if h.node != mark.node && h.node.next != mark.node {
+		d.unlink(h.node)
+		d.linkNodeBefore(h.node, mark.node)
+	}
+	return nil
+}
+
+// MoveAfter moves the element referenced by h to the position immediately after the element referenced by mark.
+// If h or mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+// The list is not modified if h and mark reference the same element.
+func (d *DoublyLinkedList[T]) MoveAfter(h, mark Handle[T]) error {
+	if !d.owns(h) || !d.owns(mark) {
+		return errInvalidHandle()
+	}
+	d.unshare()
+	h.node, mark.node = latest(h.node), latest(mark.node)
+	if h.node != mark.node && h.node.prev != mark.node {
+		d.unlink(h.node)
+		d.linkNodeAfter(h.node, mark.node)
+	}
+	return nil
+}
+
+// owns reports whether the element referenced by h is linked into this list.
+// h may reference a node the list left to a [Snapshot]: the copy of the node made for the list is checked.
+func (d *DoublyLinkedList[T]) owns(h Handle[T]) bool {
+	n := latest(h.node)
+	return n != nil && d.owner != nil && ownerOf(n) == d.owner
+}
+
+// CircularLinkedList handles.
+
+// AddFirstHandle adds e to the beginning (head) of the list, like [CircularLinkedList.AddFirst],
+// and returns the [Handle] of the new element.
+func (c *CircularLinkedList[T]) AddFirstHandle(e T) Handle[T] {
+	return Handle[T]{c.linkAfter(e, nil)}
+}
+
+// AddLastHandle appends e to the end (tail) of the list, like [CircularLinkedList.AddLast],
+// and returns the [Handle] of the new element.
+func (c *CircularLinkedList[T]) AddLastHandle(e T) Handle[T] {
+	return Handle[T]{c.linkBefore(e, nil)}
+}
+
+// InsertHandle inserts e at the given index, like [CircularLinkedList.Insert], and returns the [Handle] of the new element.
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or greater than the length of the list.
+func (c *CircularLinkedList[T]) InsertHandle(e T, index int) (Handle[T], error) {
+	if index < 0 || index > c.Len() {
+		return Handle[T]{}, errIndexOutOfBounds(index, c.Len())
+	}
+	return Handle[T]{c.linkBefore(e, c.nodeAt(index))}, nil
+}
+
+// FirstHandle returns the [Handle] of the first element (head) of the list.
+// If the list is empty, it returns an [ErrNoSuchElement] error.
+func (c *CircularLinkedList[T]) FirstHandle() (Handle[T], error) {
+	if c.IsEmpty() {
+		return Handle[T]{}, errNoSuchElement()
+	}
+	return Handle[T]{c.head}, nil
+}
+
+// LastHandle returns the [Handle] of the last element (tail) of the list.
+// If the list is empty, it returns an [ErrNoSuchElement] error.
+func (c *CircularLinkedList[T]) LastHandle() (Handle[T], error) {
+	if c.IsEmpty() {
+		return Handle[T]{}, errNoSuchElement()
+	}
+	return Handle[T]{c.tail}, nil
+}
+
+// InsertBefore inserts e immediately before the element referenced by mark and returns the [Handle] of the new element.
+// Inserting before the head makes the new element the head.
+// If mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (c *CircularLinkedList[T]) InsertBefore(mark Handle[T], e T) (Handle[T], error) {
+	if !c.owns(mark) {
+		return Handle[T]{}, errInvalidHandle()
+	}
+	return Handle[T]{c.linkBefore(e, mark.node)}, nil
+}
+
+// InsertAfter inserts e immediately after the element referenced by mark and returns the [Handle] of the new element.
+// Inserting after the tail makes the new element the tail.
+// If mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (c *CircularLinkedList[T]) InsertAfter(mark Handle[T], e T) (Handle[T], error) {
+	if !c.owns(mark) {
+		return Handle[T]{}, errInvalidHandle()
+	}
+	return Handle[T]{c.linkAfter(e, mark.node)}, nil
+}
+
+// Remove removes the element referenced by h from the list in O(1) and returns it.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (c *CircularLinkedList[T]) Remove(h Handle[T]) (T, error) {
+	if !c.owns(h) {
+		var zero T
+		return zero, errInvalidHandle()
+	}
+	return c.remove(h.node), nil
+}
+
+// Update replaces the element referenced by h with e.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (c *CircularLinkedList[T]) Update(h Handle[T], e T) error {
+	if !c.owns(h) {
+		return errInvalidHandle()
+	}
+	h.node.value = e
+	return nil
+}
+
+// MoveToFront moves the element referenced by h to the beginning (head) of the list.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (c *CircularLinkedList[T]) MoveToFront(h Handle[T]) error {
+	if !c.owns(h) {
+		return errInvalidHandle()
+	}
+	if h.node != c.head {
+		c.unlink(h.node)
+		c.linkNodeAfter(h.node, nil)
+	}
+	return nil
+}
+
+// MoveToBack moves the element referenced by h to the end (tail) of the list.
+// If h does not belong to this list, it returns an [ErrInvalidHandle] error.
+func (c *CircularLinkedList[T]) MoveToBack(h Handle[T]) error {
+	if !c.owns(h) {
+		return errInvalidHandle()
+	}
+	if h.node != c.tail {
+		c.unlink(h.node)
+		c.linkNodeBefore(h.node, nil)
+	}
+	return nil
+}
+
+// MoveBefore moves the element referenced by h to the position immediately before the element referenced by mark.
+// Moving before the head makes h the head.
+// If h or mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+// The list is not modified if h and mark reference the same element.
+func (c *CircularLinkedList[T]) MoveBefore(h, mark Handle[T]) error {
+	if !c.owns(h) || !c.owns(mark) {
+		return errInvalidHandle()
+	}
+	if h.node != mark.node && (h.node.next != mark.node || mark.node == c.head) {
+		c.unlink(h.node)
+		c.linkNodeBefore(h.node, mark.node)
+	}
+	return nil
+}
+
+// MoveAfter moves the element referenced by h to the position immediately after the element referenced by mark.
+// Moving after the tail makes h the tail.
+// If h or mark does not belong to this list, it returns an [ErrInvalidHandle] error.
+// The list is not modified if h and mark reference the same element.
+func (c *CircularLinkedList[T]) MoveAfter(h, mark Handle[T]) error {
+	if !c.owns(h) || !c.owns(mark) {
+		return errInvalidHandle()
+	}
+	if h.node != mark.node && (h.node.prev != mark.node || mark.node == c.tail) {
+		c.unlink(h.node)
+		c.linkNodeAfter(h.node, mark.node)
+	}
+	return nil
+}
+
+// owns reports whether the element referenced by h is linked into this list.
+func (c *CircularLinkedList[T]) owns(h Handle[T]) bool {
+	return h.node != nil && c.owner != nil && ownerOf(h.node) == c.owner
+}
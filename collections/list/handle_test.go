@@ -0,0 +1,218 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+// handleList is the handle API shared by DoublyLinkedList and CircularLinkedList.
+type handleList[T any] interface {
+	LinkedList[T]
+	AddFirstHandle(e T) Handle[T]
+	AddLastHandle(e T) Handle[T]
+	InsertHandle(e T, index int) (Handle[T], error)
+	FirstHandle() (Handle[T], error)
+	LastHandle() (Handle[T], error)
+	InsertBefore(mark Handle[T], e T) (Handle[T], error)
+	InsertAfter(mark Handle[T], e T) (Handle[T], error)
+	Remove(h Handle[T]) (T, error)
+	Update(h Handle[T], e T) error
+	MoveToFront(h Handle[T]) error
+	MoveToBack(h Handle[T]) error
+	MoveBefore(h, mark Handle[T]) error
+	MoveAfter(h, mark Handle[T]) error
+}
+
+func handleLists[T any]() map[string]func() handleList[T] {
+	return map[string]func() handleList[T]{
+		DoublyLinked.String(): func() handleList[T] { return &DoublyLinkedList[T]{} },
+		Circular.String():     func() handleList[T] { return &CircularLinkedList[T]{} },
+	}
+}
+
+func TestHandle_AddAndInsert(t *testing.T) {
+	for name, newList := range handleLists[string]() {
+		t.Run(name, func(t *testing.T) {
+			list := newList()
+			b := list.AddLastHandle("b")
+			a := list.AddFirstHandle("a")
+			d := list.AddLastHandle("d")
+			c, err := list.InsertHandle("c", 2)
+			if err != nil {
+				t.Fatalf("InsertHandle() error = %v", err)
+			}
+			if _, err := list.InsertHandle("x", 5); !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Errorf("InsertHandle() error = %v, want %v", err, ErrIndexOutOfBounds)
+			}
+			for h, want := range map[Handle[string]]string{a: "a", b: "b", c: "c", d: "d"} {
+				if h.Value() != want {
+					t.Errorf("Value() = %v, want %v", h.Value(), want)
+				}
+			}
+			if _, err := list.InsertBefore(a, "0"); err != nil {
+				t.Errorf("InsertBefore() error = %v", err)
+			}
+			if _, err := list.InsertAfter(d, "e"); err != nil {
+				t.Errorf("InsertAfter() error = %v", err)
+			}
+			if _, err := list.InsertAfter(b, "b2"); err != nil {
+				t.Errorf("InsertAfter() error = %v", err)
+			}
+			checkEnds[string](t, list, []string{"0", "a", "b", "b2", "c", "d", "e"})
+			if first, _ := list.FirstHandle(); first.Value() != "0" {
+				t.Errorf("FirstHandle().Value() = %v, want %v", first.Value(), "0")
+			}
+			if last, _ := list.LastHandle(); last.Value() != "e" {
+				t.Errorf("LastHandle().Value() = %v, want %v", last.Value(), "e")
+			}
+			if next, ok := b.Next(); !ok || next.Value() != "b2" {
+				t.Errorf("Next() = %v, %t, want %v, %t", next.Value(), ok, "b2", true)
+			}
+			if prev, ok := b.Prev(); !ok || prev.Value() != "a" {
+				t.Errorf("Prev() = %v, %t, want %v, %t", prev.Value(), ok, "a", true)
+			}
+		})
+	}
+}
+
+func TestHandle_Remove(t *testing.T) {
+	for name, newList := range handleLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			list := newList()
+			handles := make([]Handle[int], 5)
+			for i := range handles {
+				handles[i] = list.AddLastHandle(i)
+			}
+			for _, i := range []int{2, 0, 4} {
+				if v, err := list.Remove(handles[i]); v != i || err != nil {
+					t.Errorf("Remove() = %v, %v, want %v, nil", v, err, i)
+				}
+			}
+			checkEnds[int](t, list, []int{1, 3})
+			// removed handle is no longer valid
+			if _, err := list.Remove(handles[2]); !errors.Is(err, ErrInvalidHandle) {
+				t.Errorf("Remove() error = %v, want %v", err, ErrInvalidHandle)
+			}
+			if err := list.MoveToFront(handles[4]); !errors.Is(err, ErrInvalidHandle) {
+				t.Errorf("MoveToFront() error = %v, want %v", err, ErrInvalidHandle)
+			}
+			// elements removed by index invalidate their handles too
+			list.RemoveFirst()
+			if err := list.Update(handles[1], 10); !errors.Is(err, ErrInvalidHandle) {
+				t.Errorf("Update() error = %v, want %v", err, ErrInvalidHandle)
+			}
+			list.Remove(handles[3])
+			checkEnds[int](t, list, []int{})
+		})
+	}
+}
+
+func TestHandle_ForeignAndZero(t *testing.T) {
+	for name, newList := range handleLists[int]() {
+		t.Run(name, func(t *testing.T) {
+			list, other := newList(), newList()
+			h := list.AddLastHandle(1)
+			foreign := other.AddLastHandle(2)
+			var zero Handle[int]
+			if zero.Value() != 0 {
+				t.Errorf("Value() = %v, want %v", zero.Value(), 0)
+			}
+			if _, ok := zero.Next(); ok {
+				t.Errorf("Next() ok = %t, want %t", ok, false)
+			}
+			for _, bad := range []Handle[int]{zero, foreign} {
+				if _, err := list.Remove(bad); !errors.Is(err, ErrInvalidHandle) {
+					t.Errorf("Remove() error = %v, want %v", err, ErrInvalidHandle)
+				}
+				if _, err := list.InsertBefore(bad, 0); !errors.Is(err, ErrInvalidHandle) {
+					t.Errorf("InsertBefore() error = %v, want %v", err, ErrInvalidHandle)
+				}
+				if _, err := list.InsertAfter(bad, 0); !errors.Is(err, ErrInvalidHandle) {
+					t.Errorf("InsertAfter() error = %v, want %v", err, ErrInvalidHandle)
+				}
+				if err := list.MoveToBack(bad); !errors.Is(err, ErrInvalidHandle) {
+					t.Errorf("MoveToBack() error = %v, want %v", err, ErrInvalidHandle)
+				}
+				if err := list.MoveBefore(h, bad); !errors.Is(err, ErrInvalidHandle) {
+					t.Errorf("MoveBefore() error = %v, want %v", err, ErrInvalidHandle)
+				}
+				if err := list.MoveAfter(bad, h); !errors.Is(err, ErrInvalidHandle) {
+					t.Errorf("MoveAfter() error = %v, want %v", err, ErrInvalidHandle)
+				}
+			}
+			checkEnds[int](t, list, []int{1})
+			checkEnds[int](t, other, []int{2})
+		})
+	}
+}
+
+func TestHandle_Move(t *testing.T) {
+	tests := []struct {
+		name string
+		move func(l handleList[int], h []Handle[int]) error
+		want []int
+	}{
+		{"MoveToFront middle", func(l handleList[int], h []Handle[int]) error { return l.MoveToFront(h[2]) }, []int{2, 0, 1, 3, 4}},
+		{"MoveToFront head", func(l handleList[int], h []Handle[int]) error { return l.MoveToFront(h[0]) }, []int{0, 1, 2, 3, 4}},
+		{"MoveToFront tail", func(l handleList[int], h []Handle[int]) error { return l.MoveToFront(h[4]) }, []int{4, 0, 1, 2, 3}},
+		{"MoveToBack head", func(l handleList[int], h []Handle[int]) error { return l.MoveToBack(h[0]) }, []int{1, 2, 3, 4, 0}},
+		{"MoveToBack tail", func(l handleList[int], h []Handle[int]) error { return l.MoveToBack(h[4]) }, []int{0, 1, 2, 3, 4}},
+		{"MoveBefore", func(l handleList[int], h []Handle[int]) error { return l.MoveBefore(h[4], h[1]) }, []int{0, 4, 1, 2, 3}},
+		{"MoveBefore head", func(l handleList[int], h []Handle[int]) error { return l.MoveBefore(h[3], h[0]) }, []int{3, 0, 1, 2, 4}},
+		{"MoveBefore tail onto head", func(l handleList[int], h []Handle[int]) error { return l.MoveBefore(h[4], h[0]) }, []int{4, 0, 1, 2, 3}},
+		{"MoveBefore neighbour", func(l handleList[int], h []Handle[int]) error { return l.MoveBefore(h[1], h[2]) }, []int{0, 1, 2, 3, 4}},
+		{"MoveBefore self", func(l handleList[int], h []Handle[int]) error { return l.MoveBefore(h[1], h[1]) }, []int{0, 1, 2, 3, 4}},
+		{"MoveAfter", func(l handleList[int], h []Handle[int]) error { return l.MoveAfter(h[0], h[3]) }, []int{1, 2, 3, 0, 4}},
+		{"MoveAfter tail", func(l handleList[int], h []Handle[int]) error { return l.MoveAfter(h[1], h[4]) }, []int{0, 2, 3, 4, 1}},
+		{"MoveAfter head onto tail", func(l handleList[int], h []Handle[int]) error { return l.MoveAfter(h[0], h[4]) }, []int{1, 2, 3, 4, 0}},
+		{"MoveAfter neighbour", func(l handleList[int], h []Handle[int]) error { return l.MoveAfter(h[3], h[2]) }, []int{0, 1, 2, 3, 4}},
+		{"MoveAfter self", func(l handleList[int], h []Handle[int]) error { return l.MoveAfter(h[3], h[3]) }, []int{0, 1, 2, 3, 4}},
+	}
+	for name, newList := range handleLists[int]() {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				list := newList()
+				handles := make([]Handle[int], 5)
+				for i := range handles {
+					handles[i] = list.AddLastHandle(i)
+				}
+				if err := tt.move(list, handles); err != nil {
+					t.Fatalf("move error = %v", err)
+				}
+				checkEnds[int](t, list, tt.want)
+				// handles stay valid after a move
+				for i, h := range handles {
+					if err := list.Update(h, i*10); err != nil {
+						t.Errorf("Update() error = %v", err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestHandle_TwoElementCircle(t *testing.T) {
+	list := &CircularLinkedList[int]{}
+	a := list.AddLastHandle(1)
+	b := list.AddLastHandle(2)
+	list.MoveToFront(b)
+	checkEnds[int](t, list, []int{2, 1})
+	// the tail is followed by the head, and the head preceded by the tail
+	if next, ok := a.Next(); !ok || next != b {
+		t.Errorf("Next() of the tail = %v, %t, want %v, %t", next.Value(), ok, 2, true)
+	}
+	if prev, ok := b.Prev(); !ok || prev != a {
+		t.Errorf("Prev() of the head = %v, %t, want %v, %t", prev.Value(), ok, 1, true)
+	}
+	list.Remove(b)
+	if list.head.next != nil || list.head.prev != nil {
+		t.Errorf("single node has links %v, %v, want nil", list.head.next, list.head.prev)
+	}
+	// the only element does not wrap around to itself
+	if next, ok := a.Next(); ok {
+		t.Errorf("Next() = %v, %t, want %t", next.Value(), ok, false)
+	}
+	if prev, ok := a.Prev(); ok {
+		t.Errorf("Prev() = %v, %t, want %t", prev.Value(), ok, false)
+	}
+}
@@ -0,0 +1,288 @@
+package list
+
+import "iter"
+
+// Journal is a [LinkedList] recording its modifications so that they can be undone and redone.
+// It wraps another LinkedList; every successful AddFirst, AddLast, Insert, RemoveFirst, RemoveLast and RemoveAt
+// made through the Journal is recorded as a step of the history, along with what it takes to invert it.
+//
+// [Journal.Undo] and [Journal.Redo] move through the history one step at a time, a new modification made after
+// an Undo discarding the steps left to redo. [Journal.Checkpoint] names the current state, restored later by
+// [Journal.RestoreCheckpoint], and [Journal.Transaction] applies a batch of modifications as a single step,
+// rolled back if it fails.
+// Ex:
+//
+//	doc := NewJournal[string](nil)
+//	doc.AddLast("a").AddLast("b") // a <=> b
+//	doc.Undo()                    // a
+//	doc.Redo()                    // a <=> b
+//	doc.Transaction(func(l LinkedList[string]) error {
+//		l.RemoveFirst()
+//		return errors.New("abort") // rolled back: a <=> b
+//	})
+//
+// A Journal is not safe for concurrent use, and the wrapped list must not be modified directly once it is wrapped,
+// as the recorded steps would no longer apply to it.
+type Journal[T any] struct {
+	list        LinkedList[T]
+	undo, redo  []journalStep[T]
+	checkpoints map[string]int   // number of steps to undo to reach the checkpoint
+	batch       []journalEdit[T] // edits of the transaction in progress
+	depth       int              // number of nested transactions in progress
+}
+
+// journalStep is a unit of the history of a Journal: a single modification, or the modifications of a transaction.
+type journalStep[T any] []journalEdit[T]
+
+// journalEdit is a modification recorded by a Journal, from which its inverse is derived:
+// the inverse of inserting value at index is removing the element at index, and the other way around.
+type journalEdit[T any] struct {
+	insert bool // whether value was inserted at index, or removed from index
+	index  int
+	value  T
+}
+
+// NewJournal returns a [Journal] wrapping the given list, with an empty history.
+// If list is nil, an empty [DoublyLinkedList] is wrapped.
+func NewJournal[T any](list LinkedList[T]) *Journal[T] {
+	if list == nil {
+		list = NewLinkedList[T](DoublyLinked)
+	}
+	return &Journal[T]{list: list, checkpoints: make(map[string]int)}
+}
+
+// Undo reverts the last step of the history and returns true, or returns false if there is nothing to undo
+// or a transaction is in progress.
+func (j *Journal[T]) Undo() bool {
+	if j.depth > 0 || len(j.undo) == 0 {
+		return false
+	}
+	step := j.undo[len(j.undo)-1]
+	j.undo = j.undo[:len(j.undo)-1]
+	j.revert(step)
+	j.redo = append(j.redo, step)
+	return true
+}
+
+// Redo applies again the last step reverted by [Journal.Undo] and returns true, or returns false if there is
+// nothing to redo or a transaction is in progress.
+func (j *Journal[T]) Redo() bool {
+	if j.depth > 0 || len(j.redo) == 0 {
+		return false
+	}
+	step := j.redo[len(j.redo)-1]
+	j.redo = j.redo[:len(j.redo)-1]
+	for _, e := range step {
+		j.apply(e)
+	}
+	j.undo = append(j.undo, step)
+	return true
+}
+
+// CanUndo returns true if there is a step to undo.
+func (j *Journal[T]) CanUndo() bool {
+	return len(j.undo) > 0
+}
+
+// CanRedo returns true if there is a step to redo.
+func (j *Journal[T]) CanRedo() bool {
+	return len(j.redo) > 0
+}
+
+// Checkpoint names the current state of the list, replacing any checkpoint of the same name.
+// A checkpoint is forgotten once the history it belongs to is discarded by a modification made after an Undo.
+// An [ErrIllegalState] error is returned during a transaction.
+func (j *Journal[T]) Checkpoint(name string) error {
+	if j.depth > 0 {
+		return errInTransaction()
+	}
+	j.checkpoints[name] = len(j.undo)
+	return nil
+}
+
+// RestoreCheckpoint restores the list to its state at the named checkpoint, undoing the steps made since,
+// or redoing the steps undone since. An [ErrIllegalArgument] error is returned if there is no such checkpoint,
+// and an [ErrIllegalState] error during a transaction.
+func (j *Journal[T]) RestoreCheckpoint(name string) error {
+	if j.depth > 0 {
+		return errInTransaction()
+	}
+	steps, ok := j.checkpoints[name]
+	if !ok {
+		return errUnknownCheckpoint(name)
+	}
+	for len(j.undo) > steps {
+		j.Undo()
+	}
+	for len(j.undo) < steps {
+		j.Redo()
+	}
+	return nil
+}
+
+// Transaction calls f with the journal and records the modifications made by f as a single step of the history.
+// If f returns an error or panics, the modifications made by f are reverted, without being recorded,
+// and the error is returned or the panic goes on.
+//
+// Transactions can be nested: the modifications of a nested transaction that succeeds become part of the outer one,
+// and a nested transaction that fails only reverts its own modifications.
+// Undo, Redo and checkpoints are not available until the outermost transaction ends.
+func (j *Journal[T]) Transaction(f func(LinkedList[T]) error) error {
+	start := len(j.batch)
+	committed := false
+	j.depth++
+	defer func() {
+		if !committed {
+			j.revert(j.batch[start:])
+			j.batch = j.batch[:start]
+		}
+		j.depth--
+		if j.depth == 0 {
+			if len(j.batch) > 0 {
+				j.push(j.batch)
+			}
+			j.batch = nil
+		}
+	}()
+	if err := f(j); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// record adds the edit to the transaction in progress, or to the history as a step of its own.
+func (j *Journal[T]) record(e journalEdit[T]) {
+	if j.depth > 0 {
+		j.batch = append(j.batch, e)
+		return
+	}
+	j.push(journalStep[T]{e})
+}
+
+// push adds a new step to the history, discarding the steps left to redo and the checkpoints among them.
+func (j *Journal[T]) push(step journalStep[T]) {
+	for name, steps := range j.checkpoints {
+		if steps > len(j.undo) {
+			delete(j.checkpoints, name)
+		}
+	}
+	j.undo = append(j.undo, step)
+	j.redo = nil
+}
+
+// apply makes the edit again on the wrapped list.
+func (j *Journal[T]) apply(e journalEdit[T]) {
+	if e.insert {
+		j.list.Insert(e.value, e.index)
+	} else {
+		j.list.RemoveAt(e.index)
+	}
+}
+
+// revert applies the inverse of the edits to the wrapped list, from the last one.
+func (j *Journal[T]) revert(edits []journalEdit[T]) {
+	for i := len(edits) - 1; i >= 0; i-- {
+		if e := edits[i]; e.insert {
+			j.list.RemoveAt(e.index)
+		} else {
+			j.list.Insert(e.value, e.index)
+		}
+	}
+}
+
+func (j *Journal[T]) AddLast(e T) LinkedList[T] {
+	j.list.AddLast(e)
+	j.record(journalEdit[T]{insert: true, index: j.list.Len() - 1, value: e})
+	return j
+}
+
+func (j *Journal[T]) AddFirst(e T) LinkedList[T] {
+	j.list.AddFirst(e)
+	j.record(journalEdit[T]{insert: true, index: 0, value: e})
+	return j
+}
+
+func (j *Journal[T]) Insert(e T, index int) (bool, error) {
+	ok, err := j.list.Insert(e, index)
+	if err == nil {
+		j.record(journalEdit[T]{insert: true, index: index, value: e})
+	}
+	return ok, err
+}
+
+func (j *Journal[T]) RemoveFirst() (T, error) {
+	value, err := j.list.RemoveFirst()
+	if err == nil {
+		j.record(journalEdit[T]{index: 0, value: value})
+	}
+	return value, err
+}
+
+func (j *Journal[T]) RemoveLast() (T, error) {
+	value, err := j.list.RemoveLast()
+	if err == nil {
+		j.record(journalEdit[T]{index: j.list.Len(), value: value})
+	}
+	return value, err
+}
+
+func (j *Journal[T]) RemoveAt(index int) (T, error) {
+	value, err := j.list.RemoveAt(index)
+	if err == nil {
+		j.record(journalEdit[T]{index: index, value: value})
+	}
+	return value, err
+}
+
+func (j *Journal[T]) GetFirst() (T, error) {
+	return j.list.GetFirst()
+}
+
+func (j *Journal[T]) GetLast() (T, error) {
+	return j.list.GetLast()
+}
+
+func (j *Journal[T]) Get(index int) (T, error) {
+	return j.list.Get(index)
+}
+
+func (j *Journal[T]) GetHeadNode() (ImmutableNode[T], error) {
+	return j.list.GetHeadNode()
+}
+
+func (j *Journal[T]) GetTailNode() (ImmutableNode[T], error) {
+	return j.list.GetTailNode()
+}
+
+func (j *Journal[T]) Len() int {
+	return j.list.Len()
+}
+
+func (j *Journal[T]) IsEmpty() bool {
+	return j.list.IsEmpty()
+}
+
+func (j *Journal[T]) All() iter.Seq2[int, T] {
+	return j.list.All()
+}
+
+func (j *Journal[T]) Values() iter.Seq[T] {
+	return j.list.Values()
+}
+
+func (j *Journal[T]) ReverseAll() iter.Seq2[int, T] {
+	return j.list.ReverseAll()
+}
+
+func (j *Journal[T]) ToSlice() []T {
+	return j.list.ToSlice()
+}
+
+func (j *Journal[T]) String() string {
+	return j.list.String()
+}
+
+func (j *Journal[T]) listType() linkedListType {
+	return typeOf(j.list)
+}
@@ -0,0 +1,168 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJournal_UndoRedo(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			j := NewJournal(NewLinkedList[string](lt))
+			if j.Undo() || j.Redo() {
+				t.Fatalf("Undo() or Redo() = true on an empty history")
+			}
+			j.AddLast("b").AddFirst("a").AddLast("d")
+			j.Insert("c", 2)
+			j.Insert("x", 9) // out of bounds, not recorded
+			j.RemoveAt(1)
+			j.RemoveFirst()
+			j.RemoveLast()
+			j.RemoveAt(4) // out of bounds, not recorded
+			checkEnds[string](t, j, []string{"c"})
+
+			// each successful modification is undone in reverse order
+			states := [][]string{
+				{"c", "d"},
+				{"a", "c", "d"},
+				{"a", "b", "c", "d"},
+				{"a", "b", "d"},
+				{"a", "b"},
+				{"b"},
+				{},
+			}
+			for _, want := range states {
+				if !j.Undo() {
+					t.Fatalf("Undo() = false, want true")
+				}
+				checkEnds[string](t, j, want)
+			}
+			if j.Undo() || j.CanUndo() {
+				t.Errorf("Undo() = true after undoing the whole history")
+			}
+			for i := len(states) - 2; i >= 0; i-- {
+				if !j.Redo() {
+					t.Fatalf("Redo() = false, want true")
+				}
+				checkEnds[string](t, j, states[i])
+			}
+			j.Redo()
+			checkEnds[string](t, j, []string{"c"})
+			if j.Redo() || j.CanRedo() {
+				t.Errorf("Redo() = true after redoing the whole history")
+			}
+		})
+	}
+}
+
+func TestJournal_NewEditDiscardsRedo(t *testing.T) {
+	j := NewJournal[int](nil)
+	j.AddLast(1).AddLast(2)
+	j.Undo()
+	j.AddLast(3)
+	if j.Redo() {
+		t.Errorf("Redo() = true after a new modification")
+	}
+	checkEnds[int](t, j, []int{1, 3})
+	j.Undo()
+	j.Undo()
+	checkEnds[int](t, j, []int{})
+}
+
+func TestJournal_Checkpoints(t *testing.T) {
+	j := NewJournal[int](nil)
+	j.AddLast(1)
+	j.Checkpoint("one")
+	j.AddLast(2).AddLast(3)
+	j.Checkpoint("three")
+	j.RemoveFirst()
+
+	if err := j.RestoreCheckpoint("one"); err != nil {
+		t.Fatalf("RestoreCheckpoint(one) error = %v", err)
+	}
+	checkEnds[int](t, j, []int{1})
+	// restoring a later checkpoint redoes the steps undone since
+	j.RestoreCheckpoint("three")
+	checkEnds[int](t, j, []int{1, 2, 3})
+	j.Redo()
+	checkEnds[int](t, j, []int{2, 3})
+
+	if err := j.RestoreCheckpoint("none"); !errors.Is(err, ErrIllegalArgument) {
+		t.Errorf("RestoreCheckpoint(none) error = %v, want %v", err, ErrIllegalArgument)
+	}
+	// a modification after going back to "one" discards the history "three" belongs to
+	j.RestoreCheckpoint("one")
+	j.AddFirst(0)
+	if err := j.RestoreCheckpoint("three"); !errors.Is(err, ErrIllegalArgument) {
+		t.Errorf("RestoreCheckpoint(three) error = %v, want %v", err, ErrIllegalArgument)
+	}
+	if err := j.RestoreCheckpoint("one"); err != nil {
+		t.Errorf("RestoreCheckpoint(one) error = %v", err)
+	}
+	checkEnds[int](t, j, []int{1})
+}
+
+func TestJournal_Transaction(t *testing.T) {
+	j := NewJournal(NewLinkedListFrom(SinglyLinked, 1, 2, 3))
+	errAbort := errors.New("abort")
+
+	err := j.Transaction(func(l LinkedList[int]) error {
+		l.RemoveFirst()
+		l.AddLast(4)
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Errorf("Transaction() error = %v, want %v", err, errAbort)
+	}
+	checkEnds[int](t, j, []int{1, 2, 3})
+	if j.CanUndo() {
+		t.Errorf("CanUndo() = true after a rolled back transaction")
+	}
+
+	err = j.Transaction(func(l LinkedList[int]) error {
+		l.RemoveFirst()
+		// a nested transaction that fails only reverts its own modifications
+		j.Transaction(func(l LinkedList[int]) error {
+			l.AddFirst(9)
+			return errAbort
+		})
+		if j.Undo() || !errors.Is(j.Checkpoint("in"), ErrIllegalState) {
+			t.Errorf("Undo() or Checkpoint() allowed during a transaction")
+		}
+		return j.Transaction(func(l LinkedList[int]) error {
+			l.AddLast(4)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Errorf("Transaction() error = %v", err)
+	}
+	checkEnds[int](t, j, []int{2, 3, 4})
+	// the transaction is a single step
+	j.Undo()
+	checkEnds[int](t, j, []int{1, 2, 3})
+	j.Redo()
+	checkEnds[int](t, j, []int{2, 3, 4})
+}
+
+func TestJournal_TransactionPanic(t *testing.T) {
+	j := NewJournal[int](nil)
+	j.AddLast(1)
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recover() = %v, want boom", r)
+			}
+		}()
+		j.Transaction(func(l LinkedList[int]) error {
+			l.AddLast(2)
+			panic("boom")
+		})
+	}()
+	checkEnds[int](t, j, []int{1})
+	// the journal is usable again after the panic
+	j.AddLast(3)
+	j.Undo()
+	j.Undo()
+	checkEnds[int](t, j, []int{})
+}
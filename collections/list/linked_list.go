@@ -15,12 +15,15 @@ const (
 	SinglyLinked linkedListType = "SINGLY_LINKED"
 	DoublyLinked linkedListType = "DOUBLY_LINKED"
 	Circular     linkedListType = "CIRCULAR"
+	Unrolled     linkedListType = "UNROLLED"
 )
 
 // The LinkedList interface defines the functions for the LinkedList Abstract Data Type (ADT).
 // Any type that implements this interface can function as a LinkedList.
-// [SinglyLinkedList], [DoublyLinkedList] and [CircularLinkedList] are the known implementations.
+// [SinglyLinkedList], [DoublyLinkedList], [CircularLinkedList] and [UnrolledLinkedList] are the known implementations.
 type LinkedList[T any] interface {
+	ReadOnlyList[T]
+
 	// The AddLast method appends the given element to the end of the LinkedList.
 	//The new element becomes the tail of the list.
 	//The method returns the same LinkedList instance to support method chaining.
@@ -45,21 +48,6 @@ type LinkedList[T any] interface {
 	// error is returned if the index value is less than 0 or greater than the length of the LinkedList.
 	Insert(e T, index int) (bool, error)
 
-	// The GetFirst method returns the first element, or the head of the list.
-	// If the list is nil or empty, it returns [ErrNoSuchElement] error.
-	// In such cases, the first return value will be the zero value of the specified type T.
-	GetFirst() (T, error)
-
-	// The GetLast method returns the last element, or the tail of the list.
-	// If the list is nil or empty, it returns [ErrNoSuchElement] error.
-	//In this case, the first return value will be the zero value of the specified type T.
-	GetLast() (T, error)
-
-	// The Get method retrieves an element at the specified index from the LinkedList.
-	// A zero-based index is used. [ErrIndexOutOfBounds] error is returned
-	// if the index value is less than 0 or greater than the length of the LinkedList.
-	Get(index int) (T, error)
-
 	// The GetHeadNode method returns the first (head) node in the LinkedList.
 	// The returned node is of type [ImmutableNode] to prevent modifications
 	// to its value or its references to the next and previous nodes.
@@ -72,14 +60,6 @@ type LinkedList[T any] interface {
 	// If the LinkedList is nil or empty, the method returns an [ErrNoSuchElement] error.
 	GetTailNode() (ImmutableNode[T], error)
 
-	// The Len method returns the number of elements in the LinkedList.
-	// If the list is nil or this method is invoked on a nil reference, it will cause a panic.
-	Len() int
-
-	// The IsEmpty method returns a boolean indicating whether this LinkedList is empty.
-	// It returns true if the list is empty and false otherwise.
-	IsEmpty() bool
-
 	// The RemoveFirst method deletes the first (leftmost) element in the LinkedList and returns the removed element.
 	// It returns an [ErrNoSuchElement] error if the list is empty.
 	RemoveFirst() (T, error)
@@ -91,23 +71,58 @@ type LinkedList[T any] interface {
 	// The RemoveAt method deletes an element at a specified index and returns the removed element.
 	// A zero-based index is used. If the index is invalid, an [ErrIndexOutOfBounds] error is returned.
 	RemoveAt(index int) (T, error)
+}
+
+// ReadOnlyList defines the functions of a [LinkedList] that read its elements without modifying it.
+// Besides every [LinkedList], it is implemented by [PersistentList], whose versions are never modified,
+// by the [Snapshot] of a [DoublyLinkedList], by [SkipList], which keeps its elements sorted,
+// and by [SelfOrganizingList], which reorders its elements as they are searched.
+type ReadOnlyList[T any] interface {
+	// The GetFirst method returns the first element, or the head of the list.
+	// If the list is nil or empty, it returns [ErrNoSuchElement] error.
+	// In such cases, the first return value will be the zero value of the specified type T.
+	GetFirst() (T, error)
+
+	// The GetLast method returns the last element, or the tail of the list.
+	// If the list is nil or empty, it returns [ErrNoSuchElement] error.
+	//In this case, the first return value will be the zero value of the specified type T.
+	GetLast() (T, error)
+
+	// The Get method retrieves an element at the specified index from the LinkedList.
+	// A zero-based index is used. [ErrIndexOutOfBounds] error is returned
+	// if the index value is less than 0 or greater than the length of the LinkedList.
+	Get(index int) (T, error)
+
+	// The Len method returns the number of elements in the LinkedList.
+	// If the list is nil or this method is invoked on a nil reference, it will cause a panic.
+	Len() int
+
+	// The IsEmpty method returns a boolean indicating whether this LinkedList is empty.
+	// It returns true if the list is empty and false otherwise.
+	IsEmpty() bool
 
 	// The All method returns an iterator for the [LinkedList].
 	// This can be used with a for-range loop.
 	// When using this with a for-range, the first return value is the zero-based index of the element,
 	// and the second value is the data at that index.
 	//All method returns an Iterator to the [LinkedList]
+	//
+	// The iterators are fail-fast: if the list is structurally modified (an element is added or removed)
+	// while it is being iterated, the iteration stops by panicking with an [ErrConcurrentModification] error
+	// instead of silently skipping or repeating elements.
 	// todo: Add example
 	All() iter.Seq2[int, T]
 
 	// The Values method returns an iterator for the values in the LinkedList.
 	// This can also be used with a for-range loop.
 	// Unlike [All], this method only returns the value, not the index.
+	// Like [All], it panics with an [ErrConcurrentModification] error if the list is structurally modified during iteration.
 	// todo: Add example
 	Values() iter.Seq[T]
 
 	// The ReverseAll method returns an iterator for the LinkedList that iterates through the list in reverse order.
 	// This can be used with a for-range loop.
+	// Like [All], it panics with an [ErrConcurrentModification] error if the list is structurally modified during iteration.
 	// Todo: Add example
 	ReverseAll() iter.Seq2[int, T]
 
@@ -125,10 +140,11 @@ type LinkedList[T any] interface {
 
 // The NewLinkedList function is a factory function that returns a reference to a newly created [LinkedList]
 // implementation based on the specified linkedListType. The accepted values for [linkedListType] are [SinglyLinked],
-// [DoublyLinked], or [Circular]
+// [DoublyLinked], [Circular] or [Unrolled]
 // - If the value for linkedListType is [SinglyLinked], a reference to a newly created [SinglyLinkedList] is returned.
 // - If the value for linkedListType is [DoublyLinked], a reference to a newly created [DoublyLinkedList] is returned.
 // - If the value for linkedListType is [Circular], a reference to a newly created [CircularLinkedList] is returned.
+// - If the value for linkedListType is [Unrolled], a reference to a newly created [UnrolledLinkedList] is returned.
 //
 // If an invalid value is passed for linkedListType, the input is ignored,
 // and a [DoublyLinkedList] implementation is returned instead of throwing an error.
@@ -139,6 +155,8 @@ func NewLinkedList[T any](linkedListType linkedListType) (linkedList LinkedList[
 		linkedList = &SinglyLinkedList[T]{}
 	case Circular:
 		linkedList = &CircularLinkedList[T]{}
+	case Unrolled:
+		linkedList = &UnrolledLinkedList[T]{}
 	default:
 		linkedList = &DoublyLinkedList[T]{}
 	}
@@ -147,10 +165,11 @@ func NewLinkedList[T any](linkedListType linkedListType) (linkedList LinkedList[
 
 // The NewLinkedListFromSlice function is a factory function that returns a reference to a newly created [LinkedList]
 // implementation based on the specified linkedListType. The accepted values for [linkedListType] are [SinglyLinked],
-// [DoublyLinked], or [Circular]. As in the name, this method accepts a slice of type T along with linkedListType.
+// [DoublyLinked], [Circular] or [Unrolled]. As in the name, this method accepts a slice of type T along with linkedListType.
 // - If the value for linkedListType is [SinglyLinked], a reference to a newly created [SinglyLinkedList] is returned.
 // - If the value for linkedListType is [DoublyLinked], a reference to a newly created [DoublyLinkedList] is returned.
 // - If the value for linkedListType is [Circular], a reference to a newly created [CircularLinkedList] is returned.
+// - If the value for linkedListType is [Unrolled], a reference to a newly created [UnrolledLinkedList] is returned.
 //
 // If an invalid value is passed for linkedListType, the input is ignored,
 // and a [DoublyLinkedList] implementation is returned instead of throwing an error.
@@ -161,6 +180,8 @@ func NewLinkedListFromSlice[T any](linkedListType linkedListType, slice []T) (li
 		linkedList = &SinglyLinkedList[T]{}
 	case Circular:
 		linkedList = &CircularLinkedList[T]{}
+	case Unrolled:
+		linkedList = &UnrolledLinkedList[T]{}
 	default:
 		linkedList = &DoublyLinkedList[T]{}
 	}
@@ -61,6 +61,8 @@ func (s *singlyLinkedNode[T]) String() string {
 type doublyLinkedNode[T any] struct {
 	value      T
 	next, prev *doublyLinkedNode[T] // pointer to next and prev
+	owner      *nodeOwner           // list the node is linked into, nil once it is unlinked
+	forward    *doublyLinkedNode[T] // copy of this node made for the list once the node was left to its snapshots
 }
 
 func (dn *doublyLinkedNode[T]) Value() T {
@@ -0,0 +1,375 @@
+package list
+
+// The ListIterator interface defines a bidirectional cursor over a [LinkedList]
+// that can modify the list in place, at the position of the cursor.
+//
+// A ListIterator has no current element when it is created; its cursor sits between elements,
+// initially before the first element. Calling [ListIterator.Next] or [ListIterator.Prev] moves the cursor
+// over an element and makes it the current element. [ListIterator.Set], [ListIterator.Remove],
+// [ListIterator.InsertBefore] and [ListIterator.InsertAfter] operate on the current element
+// and return an [ErrIllegalState] error if there is none.
+//
+// All modifications made through the iterator keep the length, head and tail of the underlying list consistent.
+// If the list is structurally modified other than through the iterator itself, every subsequent
+// Next, Prev, Set, Remove, InsertBefore and InsertAfter call fails fast with an [ErrConcurrentModification] error.
+// Unlike the iterators returned by All, Values and ReverseAll, a ListIterator never panics:
+// moving past either end of the list returns an [ErrNoSuchElement] error and leaves the cursor where it is.
+// Ex:
+//
+//	dList := NewLinkedListFrom[int](DoublyLinked, 1, 2, 3, 4).(*DoublyLinkedList[int])
+//	for it := dList.ListIterator(); it.HasNext(); {
+//		if v, _ := it.Next(); v%2 == 0 {
+//			it.Remove()
+//		}
+//	}
+//	// 1 <=> 3
+type ListIterator[T any] interface {
+	// The HasNext method returns true if there is an element after the cursor.
+	HasNext() bool
+
+	// The Next method moves the cursor forward and returns the element it moved over,
+	// which becomes the current element.
+	// It returns an [ErrNoSuchElement] error if there is no next element.
+	Next() (T, error)
+
+	// The HasPrev method returns true if there is an element before the cursor.
+	HasPrev() bool
+
+	// The Prev method moves the cursor backward and returns the element it moved over,
+	// which becomes the current element.
+	// It returns an [ErrNoSuchElement] error if there is no previous element.
+	Prev() (T, error)
+
+	// The NextIndex method returns the index of the element that would be returned by a call to [ListIterator.Next],
+	// or the length of the list if the cursor is at the end of the list.
+	NextIndex() int
+
+	// The PrevIndex method returns the index of the element that would be returned by a call to [ListIterator.Prev],
+	// or -1 if the cursor is at the beginning of the list.
+	PrevIndex() int
+
+	// The Set method replaces the value of the current element with e.
+	Set(e T) error
+
+	// The Remove method unlinks the current element from the list and returns its value.
+	// After a Remove, there is no current element until Next or Prev is called again.
+	Remove() (T, error)
+
+	// The InsertBefore method links e into the list immediately before the current element.
+	// The inserted element is before the cursor, so a subsequent call to [ListIterator.Prev]
+	// returns it when the current element was reached by a call to Prev.
+	InsertBefore(e T) error
+
+	// The InsertAfter method links e into the list immediately after the current element.
+	// The inserted element is after the cursor, so a subsequent call to [ListIterator.Next]
+	// returns it when the current element was reached by a call to Next.
+	InsertAfter(e T) error
+}
+
+// ListIterator returns a [ListIterator] positioned before the first element of the list.
+// Prev on the returned iterator walks the list from the head, so it costs O(n) per call;
+// all other operations are O(1).
+func (s *SinglyLinkedList[T]) ListIterator() ListIterator[T] {
+	return &singlyListIterator[T]{list: s, next: s.head, expectedModCount: s.modCount}
+}
+
+// ListIterator returns a [ListIterator] positioned before the first element of the list.
+// All operations of the returned iterator are O(1).
+func (d *DoublyLinkedList[T]) ListIterator() ListIterator[T] {
+	return &doublyListIterator[T]{list: d, next: d.head, expectedModCount: d.modCount}
+}
+
+// ListIterator returns a [ListIterator] positioned before the first element (head) of the list.
+// The iterator does not wrap around; it stops at the tail in the forward direction and at the head backward.
+// All operations of the returned iterator are O(1).
+func (c *CircularLinkedList[T]) ListIterator() ListIterator[T] {
+	return &doublyListIterator[T]{list: c, next: c.head, expectedModCount: c.modCount}
+}
+
+// singlyListIterator is the [ListIterator] of a [SinglyLinkedList].
+// Since nodes only point forward, it remembers the node before the cursor (before)
+// and the node before the current element (lastPred) to unlink and insert in O(1).
+type singlyListIterator[T any] struct {
+	list              *SinglyLinkedList[T]
+	next, before      *singlyLinkedNode[T]
+	lastRet, lastPred *singlyLinkedNode[T]
+	nextIndex         int
+	expectedModCount  int
+}
+
+// checkModCount returns an [ErrConcurrentModification] error
+// if the list was structurally modified other than through this iterator.
+func (it *singlyListIterator[T]) checkModCount() error {
+	if it.list.modCount != it.expectedModCount {
+		return errConcurrentModification()
+	}
+	return nil
+}
+
+func (it *singlyListIterator[T]) HasNext() bool {
+	return it.nextIndex < it.list.Len()
+}
+
+func (it *singlyListIterator[T]) Next() (T, error) {
+	if err := it.checkModCount(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if !it.HasNext() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	it.lastPred, it.lastRet = it.before, it.next
+	it.before, it.next = it.next, it.next.next
+	it.nextIndex++
+	return it.lastRet.value, nil
+}
+
+func (it *singlyListIterator[T]) HasPrev() bool {
+	return it.nextIndex > 0
+}
+
+func (it *singlyListIterator[T]) Prev() (T, error) {
+	if err := it.checkModCount(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if !it.HasPrev() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	it.lastRet, it.next = it.before, it.before
+	it.nextIndex--
+	it.before = it.list.nodeAt(it.nextIndex - 1)
+	it.lastPred = it.before
+	return it.lastRet.value, nil
+}
+
+func (it *singlyListIterator[T]) NextIndex() int {
+	return it.nextIndex
+}
+
+func (it *singlyListIterator[T]) PrevIndex() int {
+	return it.nextIndex - 1
+}
+
+func (it *singlyListIterator[T]) Set(e T) error {
+	if err := it.checkModCount(); err != nil {
+		return err
+	}
+	if it.lastRet == nil {
+		return errNoCurrentElement()
+	}
+	it.lastRet.value = e
+	return nil
+}
+
+func (it *singlyListIterator[T]) Remove() (T, error) {
+	if err := it.checkModCount(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if it.lastRet == nil {
+		var zero T
+		return zero, errNoCurrentElement()
+	}
+	if it.lastRet == it.next {
+		// current element was reached by Prev, cursor is right before it
+		it.next = it.lastRet.next
+	} else {
+		// current element was reached by Next, cursor is right after it
+		it.before = it.lastPred
+		it.nextIndex--
+	}
+	value := it.list.unlinkAfter(it.lastPred, it.lastRet)
+	it.lastRet = nil
+	it.expectedModCount = it.list.modCount
+	return value, nil
+}
+
+func (it *singlyListIterator[T]) InsertBefore(e T) error {
+	if err := it.checkModCount(); err != nil {
+		return err
+	}
+	if it.lastRet == nil {
+		return errNoCurrentElement()
+	}
+	newNode := it.list.linkAfter(e, it.lastPred)
+	if it.lastRet == it.next {
+		it.before = newNode
+	}
+	it.lastPred = newNode
+	it.nextIndex++
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+func (it *singlyListIterator[T]) InsertAfter(e T) error {
+	if err := it.checkModCount(); err != nil {
+		return err
+	}
+	if it.lastRet == nil {
+		return errNoCurrentElement()
+	}
+	newNode := it.list.linkAfter(e, it.lastRet)
+	if it.lastRet != it.next {
+		it.next = newNode
+	}
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// doublyLinkedNodes is implemented by the lists made of doublyLinkedNode,
+// i.e. [DoublyLinkedList] and [CircularLinkedList].
+type doublyLinkedNodes[T any] interface {
+	Len() int
+	first() *doublyLinkedNode[T]
+	last() *doublyLinkedNode[T]
+	linkAfter(e T, pred *doublyLinkedNode[T]) *doublyLinkedNode[T]
+	linkBefore(e T, succ *doublyLinkedNode[T]) *doublyLinkedNode[T]
+	remove(n *doublyLinkedNode[T]) T
+	unshare(nodes ...**doublyLinkedNode[T])
+	modifications() int
+}
+
+// doublyListIterator is the [ListIterator] of a [DoublyLinkedList] and a [CircularLinkedList].
+// next is nil when the cursor is at the end of the list, even if the list is circular.
+type doublyListIterator[T any] struct {
+	list             doublyLinkedNodes[T]
+	next, lastRet    *doublyLinkedNode[T]
+	nextIndex        int
+	expectedModCount int
+}
+
+// checkModCount returns an [ErrConcurrentModification] error
+// if the list was structurally modified other than through this iterator.
+func (it *doublyListIterator[T]) checkModCount() error {
+	if it.list.modifications() != it.expectedModCount {
+		return errConcurrentModification()
+	}
+	return nil
+}
+
+func (it *doublyListIterator[T]) HasNext() bool {
+	return it.nextIndex < it.list.Len()
+}
+
+func (it *doublyListIterator[T]) Next() (T, error) {
+	if err := it.checkModCount(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if !it.HasNext() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	it.lastRet = it.next
+	it.nextIndex++
+	if it.HasNext() {
+		it.next = it.next.next
+	} else {
+		it.next = nil
+	}
+	return it.lastRet.value, nil
+}
+
+func (it *doublyListIterator[T]) HasPrev() bool {
+	return it.nextIndex > 0
+}
+
+func (it *doublyListIterator[T]) Prev() (T, error) {
+	if err := it.checkModCount(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if !it.HasPrev() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	if it.next == nil {
+		it.next = it.list.last()
+	} else {
+		it.next = it.next.prev
+	}
+	it.lastRet = it.next
+	it.nextIndex--
+	return it.lastRet.value, nil
+}
+
+func (it *doublyListIterator[T]) NextIndex() int {
+	return it.nextIndex
+}
+
+func (it *doublyListIterator[T]) PrevIndex() int {
+	return it.nextIndex - 1
+}
+
+func (it *doublyListIterator[T]) Set(e T) error {
+	if err := it.checkModCount(); err != nil {
+		return err
+	}
+	if it.lastRet == nil {
+		return errNoCurrentElement()
+	}
+	it.list.unshare(&it.next, &it.lastRet)
+	it.lastRet.value = e
+	it.expectedModCount = it.list.modifications()
+	return nil
+}
+
+func (it *doublyListIterator[T]) Remove() (T, error) {
+	if err := it.checkModCount(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if it.lastRet == nil {
+		var zero T
+		return zero, errNoCurrentElement()
+	}
+	it.list.unshare(&it.next, &it.lastRet)
+	if it.lastRet == it.next {
+		// current element was reached by Prev, cursor is right before it
+		if it.nextIndex+1 < it.list.Len() {
+			it.next = it.lastRet.next
+		} else {
+			it.next = nil
+		}
+	} else {
+		// current element was reached by Next, cursor is right after it
+		it.nextIndex--
+	}
+	value := it.list.remove(it.lastRet)
+	it.lastRet = nil
+	it.expectedModCount = it.list.modifications()
+	return value, nil
+}
+
+func (it *doublyListIterator[T]) InsertBefore(e T) error {
+	if err := it.checkModCount(); err != nil {
+		return err
+	}
+	if it.lastRet == nil {
+		return errNoCurrentElement()
+	}
+	it.list.unshare(&it.next, &it.lastRet)
+	it.list.linkBefore(e, it.lastRet)
+	it.nextIndex++
+	it.expectedModCount = it.list.modifications()
+	return nil
+}
+
+func (it *doublyListIterator[T]) InsertAfter(e T) error {
+	if err := it.checkModCount(); err != nil {
+		return err
+	}
+	if it.lastRet == nil {
+		return errNoCurrentElement()
+	}
+	it.list.unshare(&it.next, &it.lastRet)
+	newNode := it.list.linkAfter(e, it.lastRet)
+	if it.lastRet != it.next {
+		it.next = newNode
+	}
+	it.expectedModCount = it.list.modifications()
+	return nil
+}
@@ -0,0 +1,286 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// listIteratorOf returns the ListIterator of the given LinkedList implementation.
+func listIteratorOf[T any](l LinkedList[T]) ListIterator[T] {
+	return l.(interface{ ListIterator() ListIterator[T] }).ListIterator()
+}
+
+// checkEnds verifies that the head and tail of the list hold the first and last values of want.
+func checkEnds[T any](t *testing.T, l LinkedList[T], want []T) {
+	t.Helper()
+	if l.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+	if len(want) == 0 {
+		if _, err := l.GetHeadNode(); !errors.Is(err, ErrNoSuchElement) {
+			t.Errorf("GetHeadNode() error = %v, want %v", err, ErrNoSuchElement)
+		}
+		if _, err := l.GetTailNode(); !errors.Is(err, ErrNoSuchElement) {
+			t.Errorf("GetTailNode() error = %v, want %v", err, ErrNoSuchElement)
+		}
+		return
+	}
+	head, _ := l.GetHeadNode()
+	tail, _ := l.GetTailNode()
+	if !reflect.DeepEqual(head.Value(), want[0]) || !reflect.DeepEqual(tail.Value(), want[len(want)-1]) {
+		t.Errorf("head, tail = %v, %v, want %v, %v", head.Value(), tail.Value(), want[0], want[len(want)-1])
+	}
+	var reversed []T
+	for _, v := range l.ReverseAll() {
+		reversed = append(reversed, v)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(reversed[len(want)-1-i], want[i]) {
+			t.Fatalf("ReverseAll() = %v, want reverse of %v", reversed, want)
+		}
+	}
+}
+
+var allLinkedListTypes = []linkedListType{SinglyLinked, DoublyLinked, Circular}
+
+func TestListIterator_Traversal(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			it := listIteratorOf(NewLinkedListFrom[int](lt, 1, 2, 3))
+			if it.HasPrev() || it.PrevIndex() != -1 || it.NextIndex() != 0 {
+				t.Fatalf("new iterator: HasPrev() = %t, PrevIndex() = %d, NextIndex() = %d", it.HasPrev(), it.PrevIndex(), it.NextIndex())
+			}
+			if _, err := it.Prev(); !errors.Is(err, ErrNoSuchElement) {
+				t.Errorf("Prev() error = %v, want %v", err, ErrNoSuchElement)
+			}
+			var forward []int
+			for it.HasNext() {
+				v, err := it.Next()
+				if err != nil {
+					t.Fatalf("Next() error = %v", err)
+				}
+				forward = append(forward, v)
+			}
+			if !reflect.DeepEqual(forward, []int{1, 2, 3}) {
+				t.Errorf("forward = %v, want %v", forward, []int{1, 2, 3})
+			}
+			if _, err := it.Next(); !errors.Is(err, ErrNoSuchElement) {
+				t.Errorf("Next() error = %v, want %v", err, ErrNoSuchElement)
+			}
+			if it.NextIndex() != 3 {
+				t.Errorf("NextIndex() = %d, want %d", it.NextIndex(), 3)
+			}
+			var backward []int
+			for it.HasPrev() {
+				v, err := it.Prev()
+				if err != nil {
+					t.Fatalf("Prev() error = %v", err)
+				}
+				backward = append(backward, v)
+			}
+			if !reflect.DeepEqual(backward, []int{3, 2, 1}) {
+				t.Errorf("backward = %v, want %v", backward, []int{3, 2, 1})
+			}
+		})
+	}
+}
+
+func TestListIterator_PastTheEnds(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		for _, n := range []int{0, 1, 3} {
+			t.Run(fmt.Sprintf("%v/%d elements", lt, n), func(t *testing.T) {
+				list := NewLinkedList[int](lt)
+				for i := range n {
+					list.AddLast(i)
+				}
+				it := listIteratorOf(list)
+				for range 2 {
+					if _, err := it.Prev(); !errors.Is(err, ErrNoSuchElement) {
+						t.Errorf("Prev() at the beginning error = %v, want %v", err, ErrNoSuchElement)
+					}
+				}
+				for range n {
+					it.Next()
+				}
+				for range 2 {
+					if _, err := it.Next(); !errors.Is(err, ErrNoSuchElement) {
+						t.Errorf("Next() at the end error = %v, want %v", err, ErrNoSuchElement)
+					}
+				}
+				if it.NextIndex() != n {
+					t.Errorf("NextIndex() = %d, want %d", it.NextIndex(), n)
+				}
+				if n == 0 {
+					return
+				}
+				// removing the last element leaves the cursor at the new end
+				it.Prev()
+				it.Next()
+				it.Remove()
+				if _, err := it.Next(); !errors.Is(err, ErrNoSuchElement) {
+					t.Errorf("Next() after removing the last element error = %v, want %v", err, ErrNoSuchElement)
+				}
+				if v, err := it.Prev(); n > 1 && (err != nil || v != n-2) {
+					t.Errorf("Prev() after removing the last element = %v, %v, want %v, nil", v, err, n-2)
+				}
+			})
+		}
+	}
+}
+
+func TestListIterator_NoCurrentElement(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2)
+			it := listIteratorOf(list)
+			if err := it.Set(5); !errors.Is(err, ErrIllegalState) {
+				t.Errorf("Set() error = %v, want %v", err, ErrIllegalState)
+			}
+			if _, err := it.Remove(); !errors.Is(err, ErrIllegalState) {
+				t.Errorf("Remove() error = %v, want %v", err, ErrIllegalState)
+			}
+			if err := it.InsertBefore(5); !errors.Is(err, ErrIllegalState) {
+				t.Errorf("InsertBefore() error = %v, want %v", err, ErrIllegalState)
+			}
+			if err := it.InsertAfter(5); !errors.Is(err, ErrIllegalState) {
+				t.Errorf("InsertAfter() error = %v, want %v", err, ErrIllegalState)
+			}
+			it.Next()
+			it.Remove()
+			if _, err := it.Remove(); !errors.Is(err, ErrIllegalState) {
+				t.Errorf("second Remove() error = %v, want %v", err, ErrIllegalState)
+			}
+			checkEnds(t, list, []int{2})
+		})
+	}
+}
+
+func TestListIterator_Set(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			for it := listIteratorOf(list); it.HasNext(); {
+				v, _ := it.Next()
+				if err := it.Set(v * 10); err != nil {
+					t.Fatalf("Set() error = %v", err)
+				}
+			}
+			checkEnds(t, list, []int{10, 20, 30})
+		})
+	}
+}
+
+func TestListIterator_Remove(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String()+"/forward", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3, 4, 5, 6)
+			for it := listIteratorOf(list); it.HasNext(); {
+				if v, _ := it.Next(); v%2 == 0 {
+					if rv, err := it.Remove(); err != nil || rv != v {
+						t.Fatalf("Remove() = %v, %v, want %v, nil", rv, err, v)
+					}
+				}
+			}
+			checkEnds(t, list, []int{1, 3, 5})
+		})
+		t.Run(lt.String()+"/backward", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3, 4, 5, 6)
+			it := listIteratorOf(list)
+			for it.HasNext() {
+				it.Next()
+			}
+			for it.HasPrev() {
+				if v, _ := it.Prev(); v%2 == 1 {
+					it.Remove()
+				}
+			}
+			checkEnds(t, list, []int{2, 4, 6})
+			if it.NextIndex() != 0 {
+				t.Errorf("NextIndex() = %d, want %d", it.NextIndex(), 0)
+			}
+		})
+		t.Run(lt.String()+"/all", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			for it := listIteratorOf(list); it.HasNext(); {
+				it.Next()
+				it.Remove()
+			}
+			checkEnds(t, list, []int{})
+			list.AddLast(7).AddFirst(6)
+			checkEnds(t, list, []int{6, 7})
+		})
+		t.Run(lt.String()+"/tail then prev", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 2, 3)
+			it := listIteratorOf(list)
+			for it.HasNext() {
+				it.Next()
+			}
+			it.Remove()
+			if v, err := it.Prev(); err != nil || v != 2 {
+				t.Errorf("Prev() = %v, %v, want %v, nil", v, err, 2)
+			}
+			checkEnds(t, list, []int{1, 2})
+		})
+	}
+}
+
+func TestListIterator_Insert(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String()+"/forward", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 3, 5)
+			it := listIteratorOf(list)
+			var visited []int
+			for it.HasNext() {
+				v, _ := it.Next()
+				visited = append(visited, v)
+				if v < 10 {
+					it.InsertBefore(v - 1)
+					it.InsertAfter(v*10 + 1)
+				}
+			}
+			if !reflect.DeepEqual(visited, []int{1, 11, 3, 31, 5, 51}) {
+				t.Errorf("visited = %v, want %v", visited, []int{1, 11, 3, 31, 5, 51})
+			}
+			checkEnds(t, list, []int{0, 1, 11, 2, 3, 31, 4, 5, 51})
+		})
+		t.Run(lt.String()+"/backward", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1, 3)
+			it := listIteratorOf(list)
+			for it.HasNext() {
+				it.Next()
+			}
+			var visited []int
+			for it.HasPrev() {
+				v, _ := it.Prev()
+				visited = append(visited, v)
+				if v%2 == 1 {
+					it.InsertBefore(v - 1)
+					it.InsertAfter(v + 1)
+				}
+			}
+			if !reflect.DeepEqual(visited, []int{3, 2, 1, 0}) {
+				t.Errorf("visited = %v, want %v", visited, []int{3, 2, 1, 0})
+			}
+			checkEnds(t, list, []int{0, 1, 2, 2, 3, 4})
+		})
+		t.Run(lt.String()+"/index", func(t *testing.T) {
+			list := NewLinkedListFrom[int](lt, 1)
+			it := listIteratorOf(list)
+			it.Next()
+			it.InsertBefore(0)
+			if it.NextIndex() != 2 {
+				t.Errorf("NextIndex() = %d, want %d", it.NextIndex(), 2)
+			}
+			it.InsertAfter(2)
+			if v, err := it.Next(); err != nil || v != 2 {
+				t.Errorf("Next() = %v, %v, want %v, nil", v, err, 2)
+			}
+			checkEnds(t, list, []int{0, 1, 2})
+		})
+	}
+}
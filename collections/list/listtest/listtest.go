@@ -0,0 +1,415 @@
+// Package listtest provides a conformance test suite for implementations of [list.LinkedList].
+//
+// Any implementation, in this module or outside it, can be validated by calling [RunConformance]
+// from a test with a factory returning new empty lists:
+//
+//	func TestMyList(t *testing.T) {
+//		listtest.RunConformance(t, func() list.LinkedList[int] { return NewMyList[int]() })
+//	}
+package listtest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hegdevenky/go_commons/collections/list"
+)
+
+// RunConformance runs the conformance test suite against the [list.LinkedList] implementation
+// returned by factory, each check as a subtest of t.
+// factory must return a new empty list on every call.
+//
+// The suite covers every method of the LinkedList interface, including the edge cases of an empty list,
+// a list of one element and the index equal to the length of the list, early break out of the iterators,
+// and the String method.
+func RunConformance(t *testing.T, factory func() list.LinkedList[int]) {
+	t.Helper()
+	tests := []struct {
+		name string
+		run  func(t *testing.T, factory func() list.LinkedList[int])
+	}{
+		{"Empty", testEmpty},
+		{"OneElement", testOneElement},
+		{"AddLast", testAddLast},
+		{"AddFirst", testAddFirst},
+		{"Insert", testInsert},
+		{"InsertOutOfBounds", testInsertOutOfBounds},
+		{"Get", testGet},
+		{"RemoveFirst", testRemoveFirst},
+		{"RemoveLast", testRemoveLast},
+		{"RemoveAt", testRemoveAt},
+		{"Nodes", testNodes},
+		{"IteratorBreak", testIteratorBreak},
+		{"String", testString},
+		{"Mixed", testMixed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, factory)
+		})
+	}
+}
+
+// fromSlice returns a new list of the implementation under test holding the given values.
+func fromSlice(factory func() list.LinkedList[int], values []int) list.LinkedList[int] {
+	l := factory()
+	for _, v := range values {
+		l.AddLast(v)
+	}
+	return l
+}
+
+// checkList verifies every read method of the list against the expected elements.
+func checkList(t *testing.T, l list.LinkedList[int], want []int) {
+	t.Helper()
+	if got := l.Len(); got != len(want) {
+		t.Fatalf("Len() = %v, want %v", got, len(want))
+	}
+	if got := l.IsEmpty(); got != (len(want) == 0) {
+		t.Errorf("IsEmpty() = %v, want %v", got, len(want) == 0)
+	}
+	if got := l.ToSlice(); got == nil || !slices.Equal(got, want) {
+		t.Errorf("ToSlice() = %#v, want %v", got, want)
+	}
+	for i, w := range want {
+		if got, err := l.Get(i); err != nil || got != w {
+			t.Errorf("Get(%v) = %v, %v, want %v, nil", i, got, err, w)
+		}
+	}
+
+	var all, values, reverse []int
+	next := 0
+	for i, v := range l.All() {
+		if i != next {
+			t.Errorf("All() index = %v, want %v", i, next)
+		}
+		next++
+		all = append(all, v)
+	}
+	for v := range l.Values() {
+		values = append(values, v)
+	}
+	prev := len(want) - 1
+	for i, v := range l.ReverseAll() {
+		if i != prev {
+			t.Errorf("ReverseAll() index = %v, want %v", i, prev)
+		}
+		prev--
+		reverse = append(reverse, v)
+	}
+	slices.Reverse(reverse)
+	if !slices.Equal(all, want) || !slices.Equal(values, want) || !slices.Equal(reverse, want) {
+		t.Errorf("All(), Values(), reversed ReverseAll() = %v, %v, %v, want %v", all, values, reverse, want)
+	}
+
+	if len(want) == 0 {
+		return
+	}
+	if got, err := l.GetFirst(); err != nil || got != want[0] {
+		t.Errorf("GetFirst() = %v, %v, want %v, nil", got, err, want[0])
+	}
+	if got, err := l.GetLast(); err != nil || got != want[len(want)-1] {
+		t.Errorf("GetLast() = %v, %v, want %v, nil", got, err, want[len(want)-1])
+	}
+	head, err := l.GetHeadNode()
+	if err != nil || head == nil || head.Value() != want[0] {
+		t.Fatalf("GetHeadNode() = %v, %v, want node of %v", head, err, want[0])
+	}
+	tail, err := l.GetTailNode()
+	if err != nil || tail == nil || tail.Value() != want[len(want)-1] {
+		t.Fatalf("GetTailNode() = %v, %v, want node of %v", tail, err, want[len(want)-1])
+	}
+}
+
+// wantError verifies that err wraps target.
+func wantError(t *testing.T, method string, err, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("%v error = %v, want %v", method, err, target)
+	}
+}
+
+func testEmpty(t *testing.T, factory func() list.LinkedList[int]) {
+	l := factory()
+	checkList(t, l, []int{})
+	_, err := l.GetFirst()
+	wantError(t, "GetFirst()", err, list.ErrNoSuchElement)
+	_, err = l.GetLast()
+	wantError(t, "GetLast()", err, list.ErrNoSuchElement)
+	_, err = l.GetHeadNode()
+	wantError(t, "GetHeadNode()", err, list.ErrNoSuchElement)
+	_, err = l.GetTailNode()
+	wantError(t, "GetTailNode()", err, list.ErrNoSuchElement)
+	_, err = l.RemoveFirst()
+	wantError(t, "RemoveFirst()", err, list.ErrNoSuchElement)
+	_, err = l.RemoveLast()
+	wantError(t, "RemoveLast()", err, list.ErrNoSuchElement)
+	_, err = l.Get(0)
+	wantError(t, "Get(0)", err, list.ErrIndexOutOfBounds)
+	_, err = l.RemoveAt(0)
+	wantError(t, "RemoveAt(0)", err, list.ErrIndexOutOfBounds)
+	checkList(t, l, []int{})
+}
+
+func testOneElement(t *testing.T, factory func() list.LinkedList[int]) {
+	l := factory()
+	l.AddLast(7)
+	checkList(t, l, []int{7})
+	head, _ := l.GetHeadNode()
+	tail, _ := l.GetTailNode()
+	if head.Value() != tail.Value() {
+		t.Errorf("GetHeadNode() = %v, GetTailNode() = %v, want the same node", head.Value(), tail.Value())
+	}
+	_, err := l.Get(1)
+	wantError(t, "Get(1)", err, list.ErrIndexOutOfBounds)
+
+	removes := map[string]func() (int, error){
+		"RemoveFirst()": l.RemoveFirst,
+		"RemoveLast()":  l.RemoveLast,
+		"RemoveAt(0)":   func() (int, error) { return l.RemoveAt(0) },
+	}
+	for method, remove := range removes {
+		if l.IsEmpty() {
+			l.AddFirst(7)
+		}
+		if got, err := remove(); err != nil || got != 7 {
+			t.Errorf("%v = %v, %v, want 7, nil", method, got, err)
+		}
+		checkList(t, l, []int{})
+	}
+	// the list is reusable once emptied
+	l.AddFirst(8)
+	checkList(t, l, []int{8})
+}
+
+func testAddLast(t *testing.T, factory func() list.LinkedList[int]) {
+	l := factory()
+	var want []int
+	for i := range 5 {
+		if got := l.AddLast(i); got != l {
+			t.Errorf("AddLast(%v) returned %v, want the receiver", i, got)
+		}
+		want = append(want, i)
+		checkList(t, l, want)
+	}
+	l.AddLast(5).AddLast(6)
+	checkList(t, l, append(want, 5, 6))
+}
+
+func testAddFirst(t *testing.T, factory func() list.LinkedList[int]) {
+	l := factory()
+	var want []int
+	for i := range 5 {
+		if got := l.AddFirst(i); got != l {
+			t.Errorf("AddFirst(%v) returned %v, want the receiver", i, got)
+		}
+		want = slices.Insert(want, 0, i)
+		checkList(t, l, want)
+	}
+	l.AddFirst(5).AddLast(6)
+	checkList(t, l, append([]int{5}, append(want, 6)...))
+}
+
+func testInsert(t *testing.T, factory func() list.LinkedList[int]) {
+	for _, values := range [][]int{{}, {1}, {1, 2}, {1, 2, 3, 4, 5}, {1, 2, 3, 4, 5, 6}} {
+		for index := 0; index <= len(values); index++ {
+			t.Run(fmt.Sprintf("%v/%v", values, index), func(t *testing.T) {
+				l := fromSlice(factory, values)
+				if ok, err := l.Insert(0, index); !ok || err != nil {
+					t.Fatalf("Insert(0, %v) = %v, %v, want true, nil", index, ok, err)
+				}
+				checkList(t, l, slices.Insert(slices.Clone(values), index, 0))
+			})
+		}
+	}
+}
+
+func testInsertOutOfBounds(t *testing.T, factory func() list.LinkedList[int]) {
+	for _, values := range [][]int{{}, {1}, {1, 2, 3}} {
+		for _, index := range []int{-1, len(values) + 1, len(values) + 10} {
+			l := fromSlice(factory, values)
+			ok, err := l.Insert(0, index)
+			if ok {
+				t.Errorf("Insert(0, %v) on %v = true, want false", index, values)
+			}
+			wantError(t, fmt.Sprintf("Insert(0, %v) on %v", index, values), err, list.ErrIndexOutOfBounds)
+			checkList(t, l, values)
+		}
+	}
+}
+
+func testGet(t *testing.T, factory func() list.LinkedList[int]) {
+	values := []int{10, 20, 30, 40, 50, 60, 70}
+	l := fromSlice(factory, values)
+	checkList(t, l, values)
+	for _, index := range []int{-1, len(values), len(values) + 1} {
+		got, err := l.Get(index)
+		if got != 0 {
+			t.Errorf("Get(%v) = %v, want the zero value", index, got)
+		}
+		wantError(t, fmt.Sprintf("Get(%v)", index), err, list.ErrIndexOutOfBounds)
+	}
+}
+
+func testRemoveFirst(t *testing.T, factory func() list.LinkedList[int]) {
+	values := []int{1, 2, 3, 4}
+	l := fromSlice(factory, values)
+	for i, want := range values {
+		if got, err := l.RemoveFirst(); err != nil || got != want {
+			t.Fatalf("RemoveFirst() = %v, %v, want %v, nil", got, err, want)
+		}
+		checkList(t, l, values[i+1:])
+	}
+	_, err := l.RemoveFirst()
+	wantError(t, "RemoveFirst()", err, list.ErrNoSuchElement)
+}
+
+func testRemoveLast(t *testing.T, factory func() list.LinkedList[int]) {
+	values := []int{1, 2, 3, 4}
+	l := fromSlice(factory, values)
+	for i := len(values) - 1; i >= 0; i-- {
+		if got, err := l.RemoveLast(); err != nil || got != values[i] {
+			t.Fatalf("RemoveLast() = %v, %v, want %v, nil", got, err, values[i])
+		}
+		checkList(t, l, values[:i])
+	}
+	_, err := l.RemoveLast()
+	wantError(t, "RemoveLast()", err, list.ErrNoSuchElement)
+}
+
+func testRemoveAt(t *testing.T, factory func() list.LinkedList[int]) {
+	values := []int{1, 2, 3, 4, 5, 6}
+	for index := range values {
+		t.Run(fmt.Sprint(index), func(t *testing.T) {
+			l := fromSlice(factory, values)
+			if got, err := l.RemoveAt(index); err != nil || got != values[index] {
+				t.Fatalf("RemoveAt(%v) = %v, %v, want %v, nil", index, got, err, values[index])
+			}
+			checkList(t, l, slices.Delete(slices.Clone(values), index, index+1))
+		})
+	}
+	l := fromSlice(factory, values)
+	for _, index := range []int{-1, len(values), len(values) + 1} {
+		_, err := l.RemoveAt(index)
+		wantError(t, fmt.Sprintf("RemoveAt(%v)", index), err, list.ErrIndexOutOfBounds)
+	}
+	checkList(t, l, values)
+}
+
+func testNodes(t *testing.T, factory func() list.LinkedList[int]) {
+	values := []int{1, 2, 3, 4}
+	l := fromSlice(factory, values)
+	node, _ := l.GetHeadNode()
+	for i, want := range values {
+		if node == nil {
+			t.Fatalf("node %v is nil, want %v", i, want)
+		}
+		if node.Value() != want {
+			t.Errorf("node %v Value() = %v, want %v", i, node.Value(), want)
+		}
+		// Prev is optional (singly linked nodes have none), but must be right if present
+		if prev := node.Prev(); i > 0 && prev != nil && prev.Value() != values[i-1] {
+			t.Errorf("node %v Prev().Value() = %v, want %v", i, prev.Value(), values[i-1])
+		}
+		if i < len(values)-1 {
+			node = node.Next()
+		}
+	}
+	tail, _ := l.GetTailNode()
+	if node.Value() != tail.Value() {
+		t.Errorf("last node reached from head = %v, GetTailNode() = %v", node.Value(), tail.Value())
+	}
+}
+
+func testIteratorBreak(t *testing.T, factory func() list.LinkedList[int]) {
+	l := fromSlice(factory, []int{1, 2, 3, 4, 5})
+	var got []int
+	for i, v := range l.All() {
+		if i == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("All() with break = %v, want [1 2]", got)
+	}
+	got = nil
+	for v := range l.Values() {
+		if v == 4 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values() with break = %v, want [1 2 3]", got)
+	}
+	got = nil
+	for i, v := range l.ReverseAll() {
+		if i == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{5, 4}) {
+		t.Errorf("ReverseAll() with break = %v, want [5 4]", got)
+	}
+	// breaking out leaves the list usable
+	l.AddLast(6)
+	checkList(t, l, []int{1, 2, 3, 4, 5, 6})
+}
+
+func testString(t *testing.T, factory func() list.LinkedList[int]) {
+	values := []int{11, 22, 33}
+	l := fromSlice(factory, values)
+	s := l.String()
+	// the format is up to the implementation, but every element must appear in order
+	rest := s
+	for _, v := range values {
+		i := strings.Index(rest, fmt.Sprint(v))
+		if i < 0 {
+			t.Fatalf("String() = %q, want %v in order", s, values)
+		}
+		rest = rest[i+len(fmt.Sprint(v)):]
+	}
+	if empty := factory().String(); strings.Contains(empty, "11") {
+		t.Errorf("String() of empty list = %q, want no elements", empty)
+	}
+	l.RemoveLast()
+	if got := l.String(); got == s || strings.Contains(got, "33") {
+		t.Errorf("String() after RemoveLast() = %q, want 33 removed from %q", got, s)
+	}
+}
+
+func testMixed(t *testing.T, factory func() list.LinkedList[int]) {
+	l := factory()
+	var want []int
+	for i := range 200 {
+		switch i % 7 {
+		case 0, 3:
+			l.AddLast(i)
+			want = append(want, i)
+		case 1:
+			l.AddFirst(i)
+			want = slices.Insert(want, 0, i)
+		case 2, 5:
+			index := (i * 31) % (len(want) + 1)
+			l.Insert(i, index)
+			want = slices.Insert(want, index, i)
+		case 4:
+			index := (i * 17) % len(want)
+			if got, err := l.RemoveAt(index); err != nil || got != want[index] {
+				t.Fatalf("RemoveAt(%v) = %v, %v, want %v, nil", index, got, err, want[index])
+			}
+			want = slices.Delete(want, index, index+1)
+		case 6:
+			if got, err := l.RemoveLast(); err != nil || got != want[len(want)-1] {
+				t.Fatalf("RemoveLast() = %v, %v, want %v, nil", got, err, want[len(want)-1])
+			}
+			want = want[:len(want)-1]
+		}
+	}
+	checkList(t, l, want)
+}
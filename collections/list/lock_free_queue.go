@@ -0,0 +1,129 @@
+package list
+
+import (
+	"iter"
+	"sync/atomic"
+)
+
+// lockFreeNode defines properties for a node in LockFreeQueue
+type lockFreeNode[T any] struct {
+	value T
+	next  atomic.Pointer[lockFreeNode[T]] // pointer to next
+}
+
+// A LockFreeQueue is an unbounded multi-producer multi-consumer FIFO queue
+// that does not use locks, based on the algorithm of Michael and Scott:
+// "Simple, Fast, and Practical Non-Blocking and Blocking Concurrent Queue Algorithms" (PODC 1996).
+//
+// The queue is a singly linked list of nodes starting with a dummy node (head).
+// Producers link new nodes after the tail and consumers advance the head, both with atomic compare-and-swap,
+// so any number of goroutines can call [LockFreeQueue.Enqueue] and [LockFreeQueue.Dequeue] concurrently.
+//
+// The zero value of LockFreeQueue is an empty queue ready to use. A LockFreeQueue must not be copied after first use.
+type LockFreeQueue[T any] struct {
+	head, tail atomic.Pointer[lockFreeNode[T]]
+	len        atomic.Int64
+}
+
+// NewLockFreeQueue returns a new empty [LockFreeQueue].
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	q.init()
+	return q
+}
+
+// init links the dummy node of an empty queue, only the first call has an effect.
+func (q *LockFreeQueue[T]) init() {
+	if q.tail.Load() != nil {
+		return
+	}
+	q.head.CompareAndSwap(nil, &lockFreeNode[T]{})
+	// the head can not move before the tail is set, so it still is the dummy node.
+	q.tail.CompareAndSwap(nil, q.head.Load())
+}
+
+// Enqueue adds the given element to the tail of the queue.
+func (q *LockFreeQueue[T]) Enqueue(e T) {
+	q.init()
+	newNode := &lockFreeNode[T]{value: e}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue // tail moved while reading next, try again
+		}
+		if next != nil {
+			// tail is lagging behind, help the other producer to swing it and try again
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, newNode) {
+			// linked, now try to swing the tail; it is fine if some other goroutine already did it.
+			q.tail.CompareAndSwap(tail, newNode)
+			q.len.Add(1)
+			return
+		}
+	}
+}
+
+// Dequeue removes and returns the element at the head of the queue.
+// If the queue is empty, it returns an [ErrNoSuchElement] error.
+func (q *LockFreeQueue[T]) Dequeue() (T, error) {
+	q.init()
+	for {
+		head, tail := q.head.Load(), q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue // head moved while reading, try again
+		}
+		if next == nil {
+			var zero T
+			return zero, errNoSuchElement()
+		}
+		if head == tail {
+			// an element is being linked but tail is lagging behind, help to swing it and try again
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if q.head.CompareAndSwap(head, next) {
+			// next is the new dummy node, only the goroutine that moved the head reads and clears its value.
+			value := next.value
+			var zero T
+			next.value = zero // avoid memory leak
+			q.len.Add(-1)
+			return value, nil
+		}
+	}
+}
+
+// Len returns the number of elements in the queue.
+// Since other goroutines may enqueue or dequeue at the same time, the returned value is an approximation
+// that is only exact when the queue is not being modified.
+func (q *LockFreeQueue[T]) Len() int {
+	return int(max(q.len.Load(), 0))
+}
+
+// IsEmpty returns true if the queue had no elements at the moment it was checked.
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	head := q.head.Load()
+	return head == nil || head.next.Load() == nil
+}
+
+// Drain returns an iterator that dequeues and yields elements until the queue is empty.
+// Elements enqueued concurrently while draining are yielded as well.
+// Breaking out of the loop stops draining; the remaining elements stay in the queue.
+// Ex:
+//
+//	for job := range queue.Drain() {
+//		process(job)
+//	}
+func (q *LockFreeQueue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := q.Dequeue()
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package list
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLockFreeQueue_FIFO(t *testing.T) {
+	queues := map[string]*LockFreeQueue[int]{
+		"zero value":       {},
+		"NewLockFreeQueue": NewLockFreeQueue[int](),
+	}
+	for name, q := range queues {
+		t.Run(name, func(t *testing.T) {
+			if !q.IsEmpty() || q.Len() != 0 {
+				t.Errorf("IsEmpty(), Len() = %t, %d, want %t, %d", q.IsEmpty(), q.Len(), true, 0)
+			}
+			if v, err := q.Dequeue(); v != 0 || !errors.Is(err, ErrNoSuchElement) {
+				t.Errorf("Dequeue() = %v, %v, want %v, %v", v, err, 0, ErrNoSuchElement)
+			}
+			for i := range 5 {
+				q.Enqueue(i)
+			}
+			if q.IsEmpty() || q.Len() != 5 {
+				t.Errorf("IsEmpty(), Len() = %t, %d, want %t, %d", q.IsEmpty(), q.Len(), false, 5)
+			}
+			for i := range 3 {
+				if v, err := q.Dequeue(); v != i || err != nil {
+					t.Errorf("Dequeue() = %v, %v, want %v, nil", v, err, i)
+				}
+			}
+			q.Enqueue(5)
+			if got := slices.Collect(q.Drain()); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+				t.Errorf("Drain() = %v, want %v", got, []int{3, 4, 5})
+			}
+			if !q.IsEmpty() || q.Len() != 0 {
+				t.Errorf("IsEmpty(), Len() = %t, %d, want %t, %d", q.IsEmpty(), q.Len(), true, 0)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueue_DrainBreak(t *testing.T) {
+	q := NewLockFreeQueue[string]()
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+	for v := range q.Drain() {
+		if v == "b" {
+			break
+		}
+	}
+	if v, _ := q.Dequeue(); v != "c" {
+		t.Errorf("Dequeue() = %v, want %v", v, "c")
+	}
+}
+
+// TestLockFreeQueue_Stress runs concurrent producers and consumers, run it with -race.
+// Every element must be dequeued exactly once and the elements of a producer must be dequeued in order.
+func TestLockFreeQueue_Stress(t *testing.T) {
+	const producers, consumers, perProducer = 8, 8, 5000
+	type item struct{ producer, seq int }
+
+	q := &LockFreeQueue[item]{}
+	var produced sync.WaitGroup
+	var consumed atomic.Int64
+	seen := make([][]bool, producers)
+	for p := range seen {
+		seen[p] = make([]bool, perProducer)
+	}
+	var seenMu sync.Mutex
+
+	for p := range producers {
+		produced.Add(1)
+		go func() {
+			defer produced.Done()
+			for i := range perProducer {
+				q.Enqueue(item{p, i})
+			}
+		}()
+	}
+	done := make(chan struct{})
+	var consumersDone sync.WaitGroup
+	for range consumers {
+		consumersDone.Add(1)
+		go func() {
+			defer consumersDone.Done()
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+			for {
+				v, err := q.Dequeue()
+				if err != nil {
+					select {
+					case <-done:
+						if q.IsEmpty() {
+							return
+						}
+					default:
+					}
+					continue
+				}
+				if v.seq <= last[v.producer] {
+					t.Errorf("producer %d: got seq %d after %d", v.producer, v.seq, last[v.producer])
+				}
+				last[v.producer] = v.seq
+				seenMu.Lock()
+				if seen[v.producer][v.seq] {
+					t.Errorf("item %v dequeued twice", v)
+				}
+				seen[v.producer][v.seq] = true
+				seenMu.Unlock()
+				consumed.Add(1)
+			}
+		}()
+	}
+	produced.Wait()
+	close(done)
+	consumersDone.Wait()
+
+	if consumed.Load() != producers*perProducer {
+		t.Errorf("consumed %d, want %d", consumed.Load(), producers*perProducer)
+	}
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Errorf("IsEmpty(), Len() = %t, %d, want %t, %d", q.IsEmpty(), q.Len(), true, 0)
+	}
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	q := NewLockFreeQueue[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if i%2 == 0 {
+				q.Enqueue(i)
+			} else {
+				q.Dequeue()
+			}
+		}
+	})
+}
+
+func BenchmarkSynchronizedDoublyLinkedListQueue(b *testing.B) {
+	q := Synchronized(NewLinkedList[int](DoublyLinked))
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if i%2 == 0 {
+				q.AddLast(i)
+			} else {
+				q.RemoveFirst()
+			}
+		}
+	})
+}
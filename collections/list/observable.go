@@ -0,0 +1,263 @@
+package list
+
+import (
+	"iter"
+	"sync"
+)
+
+// Event is a modification of an [ObservableList], delivered to its listeners.
+// It is one of [Added], [Inserted], [Removed] and [Cleared]; listeners tell them apart with a type switch:
+//
+//	switch e := event.(type) {
+//	case Added[string]:
+//		fmt.Println("added", e.Value, "at", e.Index)
+//	case Removed[string]:
+//		fmt.Println("removed", e.Value, "from", e.Index)
+//	}
+type Event[T any] interface {
+	event()
+}
+
+// Added is the [Event] of an element added by AddFirst (Index is 0) or AddLast (Index is the new Len()-1).
+type Added[T any] struct {
+	Index int
+	Value T
+}
+
+// Inserted is the [Event] of an element inserted at Index by Insert.
+type Inserted[T any] struct {
+	Index int
+	Value T
+}
+
+// Removed is the [Event] of the element that was at Index, removed by RemoveFirst, RemoveLast or RemoveAt.
+type Removed[T any] struct {
+	Index int
+	Value T
+}
+
+// Cleared is the [Event] of the removal of all the elements of the list by Clear. Len is the number of removed elements.
+type Cleared[T any] struct {
+	Len int
+}
+
+func (Added[T]) event()    {}
+func (Inserted[T]) event() {}
+func (Removed[T]) event()  {}
+func (Cleared[T]) event()  {}
+
+// ObservableList is a [LinkedList] that notifies listeners of its modifications.
+// It wraps another LinkedList; every successful modification made through the ObservableList emits an [Event],
+// and methods failing with an error emit nothing.
+//
+// The events are delivered synchronously, by the goroutine modifying the list, with these guarantees:
+//   - an event is delivered once the modification is applied, so a listener sees the list already modified;
+//   - the listeners are called in the order they subscribed;
+//   - every listener receives the events in the order of the modifications. When a listener modifies the list,
+//     the event of that modification is delivered to all the listeners after the current event,
+//     and the modifying method returns before it is delivered;
+//   - a listener subscribing during the delivery of an event receives the next events only,
+//     and a listener unsubscribing during a delivery receives no events from then on.
+//
+// Like the wrapped list, an ObservableList is not safe for concurrent use.
+// The wrapped list must not be modified directly once it is wrapped, as these modifications would not be notified.
+type ObservableList[T any] struct {
+	list       LinkedList[T]
+	listeners  []*listener[T]
+	pending    []Event[T] // events not delivered yet, in the order of the modifications
+	delivering bool
+}
+
+type listener[T any] struct {
+	notify       func(Event[T])
+	unsubscribed bool
+}
+
+// Observable returns an [ObservableList] wrapping the given list. If list is nil, an empty [DoublyLinkedList] is wrapped.
+// Ex:
+//
+//	items := Observable[string](nil)
+//	unsubscribe := items.Subscribe(func(e Event[string]) { fmt.Printf("%#v\n", e) })
+//	items.AddLast("a") // list.Added[string]{Index:0, Value:"a"}
+//	unsubscribe()
+func Observable[T any](list LinkedList[T]) *ObservableList[T] {
+	if list == nil {
+		list = NewLinkedList[T](DoublyLinked)
+	}
+	return &ObservableList[T]{list: list}
+}
+
+// Subscribe registers f to be called with every subsequent [Event] of the list,
+// and returns a function unsubscribing it. Calling the returned function more than once has no effect.
+func (o *ObservableList[T]) Subscribe(f func(Event[T])) (unsubscribe func()) {
+	l := &listener[T]{notify: f}
+	o.listeners = append(o.listeners, l)
+	return func() {
+		if l.unsubscribed {
+			return
+		}
+		l.unsubscribed = true
+		// a new slice, so that a delivery in progress keeps iterating over the listeners it started with
+		o.listeners = deleteListener(o.listeners, l)
+	}
+}
+
+// Events returns a channel receiving every subsequent [Event] of the list, with a buffer of the given size,
+// and a function unsubscribing the channel and closing it.
+// The events are sent by the goroutine modifying the list, which blocks while the buffer is full,
+// so the channel must be drained. The returned function must be called by the goroutine modifying the list.
+func (o *ObservableList[T]) Events(size int) (<-chan Event[T], func()) {
+	ch := make(chan Event[T], size)
+	unsubscribe := o.Subscribe(func(e Event[T]) { ch <- e })
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			unsubscribe()
+			close(ch)
+		})
+	}
+}
+
+// emit delivers the event to the listeners, once the events of the previous modifications are delivered.
+func (o *ObservableList[T]) emit(e Event[T]) {
+	o.pending = append(o.pending, e)
+	if o.delivering {
+		// the event is delivered by the emit call already delivering, once it is done with the current one
+		return
+	}
+	o.delivering = true
+	// if a listener panics, the events left are dropped
+	defer func() { o.delivering, o.pending = false, nil }()
+	for len(o.pending) > 0 {
+		e := o.pending[0]
+		o.pending = o.pending[1:]
+		for _, l := range o.listeners {
+			if !l.unsubscribed {
+				l.notify(e)
+			}
+		}
+	}
+}
+
+func deleteListener[T any](listeners []*listener[T], l *listener[T]) []*listener[T] {
+	remaining := make([]*listener[T], 0, len(listeners))
+	for _, other := range listeners {
+		if other != l {
+			remaining = append(remaining, other)
+		}
+	}
+	return remaining
+}
+
+func (o *ObservableList[T]) AddLast(e T) LinkedList[T] {
+	o.list.AddLast(e)
+	o.emit(Added[T]{Index: o.list.Len() - 1, Value: e})
+	return o
+}
+
+func (o *ObservableList[T]) AddFirst(e T) LinkedList[T] {
+	o.list.AddFirst(e)
+	o.emit(Added[T]{Index: 0, Value: e})
+	return o
+}
+
+func (o *ObservableList[T]) Insert(e T, index int) (bool, error) {
+	ok, err := o.list.Insert(e, index)
+	if err == nil {
+		o.emit(Inserted[T]{Index: index, Value: e})
+	}
+	return ok, err
+}
+
+func (o *ObservableList[T]) RemoveFirst() (T, error) {
+	value, err := o.list.RemoveFirst()
+	if err == nil {
+		o.emit(Removed[T]{Index: 0, Value: value})
+	}
+	return value, err
+}
+
+func (o *ObservableList[T]) RemoveLast() (T, error) {
+	value, err := o.list.RemoveLast()
+	if err == nil {
+		o.emit(Removed[T]{Index: o.list.Len(), Value: value})
+	}
+	return value, err
+}
+
+func (o *ObservableList[T]) RemoveAt(index int) (T, error) {
+	value, err := o.list.RemoveAt(index)
+	if err == nil {
+		o.emit(Removed[T]{Index: index, Value: value})
+	}
+	return value, err
+}
+
+// Clear removes all the elements of the list and emits a single [Cleared] event,
+// or does nothing if the list is already empty.
+func (o *ObservableList[T]) Clear() {
+	n := o.list.Len()
+	if n == 0 {
+		return
+	}
+	if c, ok := o.list.(interface{ Clear() }); ok {
+		c.Clear()
+	} else {
+		for !o.list.IsEmpty() {
+			o.list.RemoveLast()
+		}
+	}
+	o.emit(Cleared[T]{Len: n})
+}
+
+func (o *ObservableList[T]) GetFirst() (T, error) {
+	return o.list.GetFirst()
+}
+
+func (o *ObservableList[T]) GetLast() (T, error) {
+	return o.list.GetLast()
+}
+
+func (o *ObservableList[T]) Get(index int) (T, error) {
+	return o.list.Get(index)
+}
+
+func (o *ObservableList[T]) GetHeadNode() (ImmutableNode[T], error) {
+	return o.list.GetHeadNode()
+}
+
+func (o *ObservableList[T]) GetTailNode() (ImmutableNode[T], error) {
+	return o.list.GetTailNode()
+}
+
+func (o *ObservableList[T]) Len() int {
+	return o.list.Len()
+}
+
+func (o *ObservableList[T]) IsEmpty() bool {
+	return o.list.IsEmpty()
+}
+
+func (o *ObservableList[T]) All() iter.Seq2[int, T] {
+	return o.list.All()
+}
+
+func (o *ObservableList[T]) Values() iter.Seq[T] {
+	return o.list.Values()
+}
+
+func (o *ObservableList[T]) ReverseAll() iter.Seq2[int, T] {
+	return o.list.ReverseAll()
+}
+
+func (o *ObservableList[T]) ToSlice() []T {
+	return o.list.ToSlice()
+}
+
+func (o *ObservableList[T]) String() string {
+	return o.list.String()
+}
+
+func (o *ObservableList[T]) listType() linkedListType {
+	return typeOf(o.list)
+}
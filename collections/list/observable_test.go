@@ -0,0 +1,151 @@
+package list
+
+import (
+	"reflect"
+	"testing"
+)
+
+// recorder returns a listener appending the events it receives to events.
+func recorder[T any](events *[]Event[T]) func(Event[T]) {
+	return func(e Event[T]) { *events = append(*events, e) }
+}
+
+func TestObservableList_Events(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			o := Observable(NewLinkedList[string](lt))
+			var got []Event[string]
+			o.Subscribe(recorder(&got))
+
+			o.AddLast("b").AddFirst("a").AddLast("d")
+			o.Insert("c", 2)
+			o.Insert("x", 9) // out of bounds, no event
+			o.RemoveAt(1)
+			o.RemoveLast()
+			o.RemoveFirst()
+			o.RemoveAt(5) // out of bounds, no event
+			o.AddLast("e")
+			o.Clear()
+			o.Clear() // already empty, no event
+			o.RemoveFirst()
+
+			want := []Event[string]{
+				Added[string]{Index: 0, Value: "b"},
+				Added[string]{Index: 0, Value: "a"},
+				Added[string]{Index: 2, Value: "d"},
+				Inserted[string]{Index: 2, Value: "c"},
+				Removed[string]{Index: 1, Value: "b"},
+				Removed[string]{Index: 2, Value: "d"},
+				Removed[string]{Index: 0, Value: "a"},
+				Added[string]{Index: 1, Value: "e"},
+				Cleared[string]{Len: 2},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("events = %v, want %v", got, want)
+			}
+			checkEnds[string](t, o, []string{})
+		})
+	}
+}
+
+func TestObservableList_ListenerSeesModifiedList(t *testing.T) {
+	o := Observable[int](nil)
+	o.Subscribe(func(e Event[int]) {
+		if added, ok := e.(Added[int]); ok {
+			if v, _ := o.Get(added.Index); v != added.Value {
+				t.Errorf("Get(%v) = %v in the listener, want %v", added.Index, v, added.Value)
+			}
+		}
+	})
+	o.AddLast(1).AddLast(2).AddFirst(0)
+}
+
+func TestObservableList_NestedModificationOrdering(t *testing.T) {
+	o := Observable[int](nil)
+	var first, second []Event[int]
+	// the first listener reacts to the addition of a negative value by removing it
+	o.Subscribe(func(e Event[int]) {
+		first = append(first, e)
+		if added, ok := e.(Added[int]); ok && added.Value < 0 {
+			o.RemoveAt(added.Index)
+			if o.Len() != 1 {
+				t.Errorf("Len() = %v after RemoveAt in the listener, want 1", o.Len())
+			}
+		}
+	})
+	o.Subscribe(recorder(&second))
+
+	o.AddLast(1).AddLast(-1)
+	want := []Event[int]{
+		Added[int]{Index: 0, Value: 1},
+		Added[int]{Index: 1, Value: -1},
+		Removed[int]{Index: 1, Value: -1},
+	}
+	// the second listener receives the addition before the removal, though the removal happened during its delivery
+	if !reflect.DeepEqual(first, want) || !reflect.DeepEqual(second, want) {
+		t.Errorf("events = %v and %v, want %v for both listeners", first, second, want)
+	}
+}
+
+func TestObservableList_Unsubscribe(t *testing.T) {
+	o := Observable[int](nil)
+	var calls []string
+	var unsubscribeB func()
+	o.Subscribe(func(e Event[int]) {
+		calls = append(calls, "a")
+		// unsubscribing b during the delivery: b is not called for this event
+		unsubscribeB()
+	})
+	unsubscribeB = o.Subscribe(func(e Event[int]) { calls = append(calls, "b") })
+	unsubscribeC := o.Subscribe(func(e Event[int]) {
+		calls = append(calls, "c")
+		if len(calls) == 2 {
+			// subscribing during the delivery: d receives the next events only
+			o.Subscribe(func(e Event[int]) { calls = append(calls, "d") })
+		}
+	})
+
+	o.AddLast(1)
+	o.AddLast(2)
+	unsubscribeC()
+	unsubscribeC()
+	o.AddLast(3)
+	if want := []string{"a", "c", "a", "c", "d", "a", "d"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestObservableList_Events_Channel(t *testing.T) {
+	o := Observable(NewLinkedList[int](Circular))
+	events, unsubscribe := o.Events(4)
+	o.AddLast(1)
+	o.AddFirst(0)
+	o.RemoveLast()
+	unsubscribe()
+	unsubscribe()
+	o.AddLast(2) // not sent, the channel is closed
+
+	var got []Event[int]
+	for e := range events {
+		got = append(got, e)
+	}
+	want := []Event[int]{Added[int]{0, 1}, Added[int]{0, 0}, Removed[int]{1, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestObservableList_FunctionsKeepWrappedType(t *testing.T) {
+	o := Observable(NewLinkedListFrom(Circular, 1, 2, 3, 4))
+	var got []Event[int]
+	o.Subscribe(recorder(&got))
+	if _, ok := Filter[int](o, isEven).(*CircularLinkedList[int]); !ok {
+		t.Errorf("Filter() did not return a %v list", Circular)
+	}
+	// RemoveIf removes through the wrapper, from the tail, so the indexes are those of the list at each removal
+	RemoveIf[int](o, isEven)
+	want := []Event[int]{Removed[int]{3, 4}, Removed[int]{1, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,195 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// PersistentList is an immutable singly linked list (a cons list). Its methods never modify a list:
+// Prepend, Tail, Drop, Take and Concat return new versions sharing their nodes with the original one,
+// which stays valid and unchanged. Because nothing is ever modified, versions can be kept around
+// (ex: the successive states of an event-sourced entity) and read from several goroutines without locking.
+//
+// The zero value is an empty list. It implements [ReadOnlyList].
+// Ex:
+//
+//	base := NewPersistentList(2, 3) // 2 -> 3
+//	a := base.Prepend(1)            // 1 -> 2 -> 3, shares 2 -> 3 with base
+//	b := base.Prepend(0)            // 0 -> 2 -> 3, shares 2 -> 3 with base and a
+type PersistentList[T any] struct {
+	head *persistentNode[T]
+}
+
+// persistentNode is a node of a PersistentList. A node is never modified once created.
+type persistentNode[T any] struct {
+	value T
+	next  *persistentNode[T]
+	len   int // number of nodes from this one to the end of the list
+}
+
+// NewPersistentList returns a [PersistentList] holding the given elements, in order.
+func NewPersistentList[T any](elements ...T) PersistentList[T] {
+	var p PersistentList[T]
+	for i := len(elements) - 1; i >= 0; i-- {
+		p = p.Prepend(elements[i])
+	}
+	return p
+}
+
+// Prepend returns a new version of the list with e added to the beginning, sharing all the nodes of this one.
+// It takes O(1) time.
+func (p PersistentList[T]) Prepend(e T) PersistentList[T] {
+	return PersistentList[T]{head: &persistentNode[T]{value: e, next: p.head, len: p.Len() + 1}}
+}
+
+// Tail returns the list without its first element, which shares all its nodes with this one.
+// It takes O(1) time. An [ErrNoSuchElement] error is returned if the list is empty.
+func (p PersistentList[T]) Tail() (PersistentList[T], error) {
+	if p.head == nil {
+		return p, errNoSuchElement()
+	}
+	return PersistentList[T]{head: p.head.next}, nil
+}
+
+// Drop returns the list without its first k elements, which shares all its nodes with this one.
+// It takes O(k) time. If k is not greater than 0 the list itself is returned,
+// and if k is greater than or equal to the length of the list an empty list is returned.
+func (p PersistentList[T]) Drop(k int) PersistentList[T] {
+	cur := p.head
+	for ; k > 0 && cur != nil; k-- {
+		cur = cur.next
+	}
+	return PersistentList[T]{head: cur}
+}
+
+// Take returns a list of the first k elements of this one. It takes O(k) time, as the k nodes are copied,
+// except when k is greater than or equal to the length of the list: the list itself is then returned.
+// If k is not greater than 0 an empty list is returned.
+func (p PersistentList[T]) Take(k int) PersistentList[T] {
+	if k >= p.Len() {
+		return p
+	}
+	if k <= 0 {
+		return PersistentList[T]{}
+	}
+	return p.copyPrefix(k, nil)
+}
+
+// Concat returns a list of the elements of this list followed by the elements of other.
+// The returned list shares all the nodes of other, and the nodes of this list are copied,
+// so it takes O(n) time, n being the length of this list. If either list is empty, the other one is returned.
+func (p PersistentList[T]) Concat(other PersistentList[T]) PersistentList[T] {
+	switch {
+	case p.head == nil:
+		return other
+	case other.head == nil:
+		return p
+	}
+	return p.copyPrefix(p.Len(), other.head)
+}
+
+// copyPrefix returns a list of copies of the first k nodes of the list, followed by the nodes from rest.
+func (p PersistentList[T]) copyPrefix(k int, rest *persistentNode[T]) PersistentList[T] {
+	// the copies are linked front to back, so they are only modified before the new list is returned
+	restLen := 0
+	if rest != nil {
+		restLen = rest.len
+	}
+	first := &persistentNode[T]{}
+	last := first
+	for cur := p.head; k > 0; k-- {
+		last.next = &persistentNode[T]{value: cur.value, len: k + restLen}
+		last, cur = last.next, cur.next
+	}
+	last.next = rest
+	return PersistentList[T]{head: first.next}
+}
+
+func (p PersistentList[T]) GetFirst() (T, error) {
+	if p.head == nil {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return p.head.value, nil
+}
+
+// GetLast returns the last element of the list. It takes O(n) time, as the list has no tail pointer.
+func (p PersistentList[T]) GetLast() (T, error) {
+	if p.head == nil {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return p.Get(p.Len() - 1)
+}
+
+func (p PersistentList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= p.Len() {
+		var zero T
+		return zero, errIndexOutOfBounds(index, p.Len())
+	}
+	return p.Drop(index).head.value, nil
+}
+
+func (p PersistentList[T]) Len() int {
+	if p.head == nil {
+		return 0
+	}
+	return p.head.len
+}
+
+func (p PersistentList[T]) IsEmpty() bool {
+	return p.head == nil
+}
+
+// All returns an iterator over the indexes and elements of the list.
+// As the list is never modified, the iteration cannot fail.
+func (p PersistentList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, cur := 0, p.head; cur != nil; i, cur = i+1, cur.next {
+			if !yield(i, cur.value) {
+				return
+			}
+		}
+	}
+}
+
+func (p PersistentList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := p.head; cur != nil; cur = cur.next {
+			if !yield(cur.value) {
+				return
+			}
+		}
+	}
+}
+
+// ReverseAll returns an iterator over the indexes and elements of the list, from the last one.
+// The elements are first copied into a slice, as the nodes have no link to their predecessor.
+func (p PersistentList[T]) ReverseAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		sl := p.ToSlice()
+		for i := len(sl) - 1; i >= 0; i-- {
+			if !yield(i, sl[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (p PersistentList[T]) ToSlice() []T {
+	slice := make([]T, 0, p.Len())
+	for v := range p.Values() {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+func (p PersistentList[T]) String() string {
+	var sb strings.Builder
+	for v := range p.Values() {
+		sb.WriteString(fmt.Sprintf("[%v|—]⃓——→ ", v))
+	}
+	sb.WriteString("<nil>")
+	return sb.String()
+}
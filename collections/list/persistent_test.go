@@ -0,0 +1,137 @@
+package list
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// checkReadOnlyList checks the elements of p through every read method of ReadOnlyList.
+func checkReadOnlyList[T any](t *testing.T, p ReadOnlyList[T], want []T) {
+	t.Helper()
+	if p.Len() != len(want) || p.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("Len() = %v, IsEmpty() = %v, want %v elements", p.Len(), p.IsEmpty(), len(want))
+	}
+	if got := p.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+	for i, v := range p.All() {
+		if got, err := p.Get(i); err != nil || !reflect.DeepEqual(got, v) {
+			t.Errorf("Get(%v) = %v, %v, want %v", i, got, err, v)
+		}
+	}
+	var reversed []T
+	for _, v := range p.ReverseAll() {
+		reversed = append(reversed, v)
+	}
+	for i, v := range reversed {
+		if !reflect.DeepEqual(v, want[len(want)-1-i]) {
+			t.Fatalf("ReverseAll() = %v, want the reverse of %v", reversed, want)
+		}
+	}
+	if len(want) == 0 {
+		if _, err := p.GetFirst(); !errors.Is(err, ErrNoSuchElement) {
+			t.Errorf("GetFirst() error = %v, want %v", err, ErrNoSuchElement)
+		}
+		if _, err := p.GetLast(); !errors.Is(err, ErrNoSuchElement) {
+			t.Errorf("GetLast() error = %v, want %v", err, ErrNoSuchElement)
+		}
+		return
+	}
+	if got, _ := p.GetFirst(); !reflect.DeepEqual(got, want[0]) {
+		t.Errorf("GetFirst() = %v, want %v", got, want[0])
+	}
+	if got, _ := p.GetLast(); !reflect.DeepEqual(got, want[len(want)-1]) {
+		t.Errorf("GetLast() = %v, want %v", got, want[len(want)-1])
+	}
+}
+
+func TestPersistentList_ZeroValue(t *testing.T) {
+	var p PersistentList[int]
+	checkReadOnlyList(t, p, []int{})
+	if _, err := p.Tail(); !errors.Is(err, ErrNoSuchElement) {
+		t.Errorf("Tail() error = %v, want %v", err, ErrNoSuchElement)
+	}
+	if _, err := p.Get(0); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("Get(0) error = %v, want %v", err, ErrIndexOutOfBounds)
+	}
+	if got := p.String(); got != "<nil>" {
+		t.Errorf("String() = %q, want %q", got, "<nil>")
+	}
+}
+
+func TestPersistentList_VersionsShareTails(t *testing.T) {
+	base := NewPersistentList(2, 3)
+	a := base.Prepend(1)
+	b := base.Prepend(0)
+	checkReadOnlyList(t, base, []int{2, 3})
+	checkReadOnlyList(t, a, []int{1, 2, 3})
+	checkReadOnlyList(t, b, []int{0, 2, 3})
+	if a.head.next != base.head || b.head.next != base.head {
+		t.Errorf("Prepend() did not share the nodes of the original list")
+	}
+
+	tail, err := a.Tail()
+	if err != nil || tail.head != base.head {
+		t.Errorf("Tail() = %v, %v, want the nodes of %v", tail, err, base)
+	}
+	if got := a.String(); got != "[1|—]⃓——→ [2|—]⃓——→ [3|—]⃓——→ <nil>" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestPersistentList_DropAndTake(t *testing.T) {
+	p := NewPersistentList(1, 2, 3, 4)
+	tests := []struct {
+		k              int
+		wantDrop, want []int
+	}{
+		{-1, []int{1, 2, 3, 4}, []int{}},
+		{0, []int{1, 2, 3, 4}, []int{}},
+		{1, []int{2, 3, 4}, []int{1}},
+		{3, []int{4}, []int{1, 2, 3}},
+		{4, []int{}, []int{1, 2, 3, 4}},
+		{10, []int{}, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		checkReadOnlyList(t, p.Drop(tt.k), tt.wantDrop)
+		checkReadOnlyList(t, p.Take(tt.k), tt.want)
+	}
+	if p.Drop(2).head != p.head.next.next {
+		t.Errorf("Drop(2) did not share the nodes of the original list")
+	}
+	if p.Take(4).head != p.head {
+		t.Errorf("Take(Len()) did not return the original list")
+	}
+	checkReadOnlyList(t, p, []int{1, 2, 3, 4})
+}
+
+func TestPersistentList_Concat(t *testing.T) {
+	a, b := NewPersistentList(1, 2), NewPersistentList(3, 4, 5)
+	ab := a.Concat(b)
+	checkReadOnlyList(t, ab, []int{1, 2, 3, 4, 5})
+	if ab.Drop(2).head != b.head {
+		t.Errorf("Concat() did not share the nodes of other")
+	}
+	checkReadOnlyList(t, a, []int{1, 2})
+	checkReadOnlyList(t, b, []int{3, 4, 5})
+	// versions of the concatenation do not affect each other
+	checkReadOnlyList(t, ab.Take(3).Concat(NewPersistentList(9)), []int{1, 2, 3, 9})
+	checkReadOnlyList(t, ab, []int{1, 2, 3, 4, 5})
+
+	var empty PersistentList[int]
+	if got := empty.Concat(b); got.head != b.head {
+		t.Errorf("empty.Concat(b) = %v, want b", got)
+	}
+	if got := a.Concat(empty); got.head != a.head {
+		t.Errorf("a.Concat(empty) = %v, want a", got)
+	}
+}
+
+func TestPersistentList_ReadOnlyList(t *testing.T) {
+	// PersistentList and the mutable lists can be used through the same interface
+	lists := []ReadOnlyList[int]{NewPersistentList(1, 2, 3), NewLinkedListFrom(DoublyLinked, 1, 2, 3)}
+	for _, l := range lists {
+		checkReadOnlyList(t, l, []int{1, 2, 3})
+	}
+}
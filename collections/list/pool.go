@@ -0,0 +1,134 @@
+package list
+
+import "sync"
+
+// NodePool provides the nodes of a [DoublyLinkedList] or a [CircularLinkedList] created with a pool
+// (see [NewDoublyLinkedListWithPool]) and takes back the nodes of the removed elements, so that a list with a high
+// churn of elements, such as a queue, does not allocate a node for every added element.
+//
+// The pools are created by [NewFreeListPool], [NewSyncPool] and [NewArenaPool].
+// A pool can be shared by several lists of the same element type;
+// only the pool returned by NewSyncPool can be shared by lists used from different goroutines.
+//
+// As the node of a removed element is reused for another element, a [Handle] or an [ImmutableNode]
+// of a list with a pool must not be used once its element is removed: it may refer to a different element,
+// possibly of another list sharing the pool.
+type NodePool[T any] interface {
+	// get returns an unlinked node, its value is the zero value of T.
+	get() *doublyLinkedNode[T]
+	// put takes back a node that is no longer linked into any list, its value is the zero value of T.
+	put(n *doublyLinkedNode[T])
+}
+
+// NewDoublyLinkedListWithPool returns an empty [DoublyLinkedList] whose nodes are taken from the given pool
+// and given back to it when their elements are removed. If pool is nil, nodes are allocated as usual.
+// Ex:
+//
+//	queue := NewDoublyLinkedListWithPool(NewFreeListPool[Task](1024))
+func NewDoublyLinkedListWithPool[T any](pool NodePool[T]) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{pool: pool}
+}
+
+// NewCircularLinkedListWithPool returns an empty [CircularLinkedList] whose nodes are taken from the given pool
+// and given back to it when their elements are removed. If pool is nil, nodes are allocated as usual.
+func NewCircularLinkedListWithPool[T any](pool NodePool[T]) *CircularLinkedList[T] {
+	return &CircularLinkedList[T]{pool: pool}
+}
+
+// NewFreeListPool returns a [NodePool] keeping the given nodes in a linked free list,
+// up to maxFree nodes (without limit if maxFree is not greater than 0); the nodes beyond are left to the GC.
+// It is the cheapest pool, but it is not safe for concurrent use.
+func NewFreeListPool[T any](maxFree int) NodePool[T] {
+	return &freeListPool[T]{max: maxFree}
+}
+
+// NewSyncPool returns a [NodePool] backed by a [sync.Pool]: it is safe for concurrent use,
+// and the GC may drop the unused nodes.
+func NewSyncPool[T any]() NodePool[T] {
+	return &syncPool[T]{pool: sync.Pool{New: func() any { return new(doublyLinkedNode[T]) }}}
+}
+
+// NewArenaPool returns a [NodePool] allocating nodes in chunks (slabs) of chunkSize nodes,
+// so that adding chunkSize elements costs a single allocation. The given back nodes are kept in a free list
+// and reused before taking new nodes from the current chunk. A chunk is freed by the GC only once none of its
+// nodes is in use. It is not safe for concurrent use.
+// If chunkSize is not greater than 0, chunks of 64 nodes are allocated.
+func NewArenaPool[T any](chunkSize int) NodePool[T] {
+	if chunkSize <= 0 {
+		chunkSize = 64
+	}
+	return &arenaPool[T]{chunkSize: chunkSize}
+}
+
+type freeListPool[T any] struct {
+	free     *doublyLinkedNode[T] // free nodes, linked by next
+	len, max int
+}
+
+func (p *freeListPool[T]) get() *doublyLinkedNode[T] {
+	if p.free == nil {
+		return new(doublyLinkedNode[T])
+	}
+	n := p.free
+	p.free, n.next = n.next, nil
+	p.len--
+	return n
+}
+
+func (p *freeListPool[T]) put(n *doublyLinkedNode[T]) {
+	if p.max > 0 && p.len >= p.max {
+		return
+	}
+	p.free, n.next = n, p.free
+	p.len++
+}
+
+type syncPool[T any] struct {
+	pool sync.Pool
+}
+
+func (p *syncPool[T]) get() *doublyLinkedNode[T] {
+	return p.pool.Get().(*doublyLinkedNode[T])
+}
+
+func (p *syncPool[T]) put(n *doublyLinkedNode[T]) {
+	p.pool.Put(n)
+}
+
+type arenaPool[T any] struct {
+	freeListPool[T]                       // given back nodes, without limit
+	chunk           []doublyLinkedNode[T] // nodes of the current chunk not handed out yet
+	chunkSize       int
+}
+
+func (p *arenaPool[T]) get() *doublyLinkedNode[T] {
+	if p.free != nil {
+		return p.freeListPool.get()
+	}
+	if len(p.chunk) == 0 {
+		p.chunk = make([]doublyLinkedNode[T], p.chunkSize)
+	}
+	n := &p.chunk[0]
+	p.chunk = p.chunk[1:]
+	return n
+}
+
+// newNode returns an unlinked node holding e, taken from the pool if there is one.
+func newNode[T any](pool NodePool[T], e T) *doublyLinkedNode[T] {
+	if pool == nil {
+		return &doublyLinkedNode[T]{value: e}
+	}
+	n := pool.get()
+	n.value = e
+	return n
+}
+
+// releaseNode gives the unlinked node back to the pool, if there is one.
+func releaseNode[T any](pool NodePool[T], n *doublyLinkedNode[T]) {
+	if pool == nil {
+		return
+	}
+	var zero T
+	n.value = zero // do not retain the removed element
+	pool.put(n)
+}
@@ -0,0 +1,168 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+)
+
+// poolList is the API of the lists that can be created with a pool, through which nodes are removed.
+type poolList interface {
+	handleList[int]
+	ListIterator() ListIterator[int]
+	RemoveRange(from, to int) error
+	AddAll(seq iter.Seq[int]) LinkedList[int]
+	Clear()
+}
+
+func poolLists() map[string]func(pool NodePool[int]) poolList {
+	return map[string]func(pool NodePool[int]) poolList{
+		DoublyLinked.String(): func(pool NodePool[int]) poolList { return NewDoublyLinkedListWithPool(pool) },
+		Circular.String():     func(pool NodePool[int]) poolList { return NewCircularLinkedListWithPool(pool) },
+	}
+}
+
+func nodePools() map[string]func() NodePool[int] {
+	return map[string]func() NodePool[int]{
+		"none":     func() NodePool[int] { return nil },
+		"FreeList": func() NodePool[int] { return NewFreeListPool[int](0) },
+		"Sync":     func() NodePool[int] { return NewSyncPool[int]() },
+		"Arena":    func() NodePool[int] { return NewArenaPool[int](4) },
+	}
+}
+
+func TestNodePool_Churn(t *testing.T) {
+	for name, newList := range poolLists() {
+		for poolName, newPool := range nodePools() {
+			t.Run(name+"/"+poolName, func(t *testing.T) {
+				pool := newPool()
+				// two lists sharing the pool, the nodes removed from one are reused by the other
+				a, b := newList(pool), newList(pool)
+				for i := range 10 {
+					a.AddLast(i)
+				}
+				for range 5 {
+					v, _ := a.RemoveFirst()
+					b.AddFirst(v)
+				}
+				a.RemoveAt(2)
+				a.Insert(20, 1)
+				h, _ := b.FirstHandle()
+				b.Remove(h)
+				b.AddLast(40)
+				RemoveIf[int](a, func(e int) bool { return e == 9 })
+				checkEnds(t, a, []int{5, 20, 6, 8})
+				checkEnds(t, b, []int{3, 2, 1, 0, 40})
+
+				for it := a.ListIterator(); it.HasNext(); {
+					if v, _ := it.Next(); v == 20 {
+						it.Remove()
+					}
+				}
+				a.RemoveRange(1, 2)
+				b.Clear()
+				b.AddAll(slices.Values([]int{0, 10, 20}))
+				checkEnds(t, a, []int{5, 8})
+				checkEnds(t, b, []int{0, 10, 20})
+			})
+		}
+	}
+}
+
+func TestNodePool_ReleasedNodesAreCleared(t *testing.T) {
+	pool := NewFreeListPool[*int](0)
+	l := NewDoublyLinkedListWithPool(pool)
+	v := 1
+	l.AddLast(&v).AddLast(&v)
+	l.RemoveFirst()
+	l.Clear()
+	p := pool.(*freeListPool[*int])
+	if p.len != 2 {
+		t.Fatalf("free nodes = %v, want 2", p.len)
+	}
+	for n := p.free; n != nil; n = n.next {
+		if n.value != nil || n.prev != nil || n.owner != nil {
+			t.Errorf("released node = %+v, want a cleared node", *n)
+		}
+	}
+}
+
+func TestNewFreeListPool_MaxFree(t *testing.T) {
+	pool := NewFreeListPool[int](3)
+	l := NewDoublyLinkedListWithPool(pool)
+	for i := range 10 {
+		l.AddLast(i)
+	}
+	l.Clear()
+	if got := pool.(*freeListPool[int]).len; got != 3 {
+		t.Errorf("free nodes = %v, want 3", got)
+	}
+}
+
+func TestNewArenaPool_Chunks(t *testing.T) {
+	pool := NewArenaPool[int](4).(*arenaPool[int])
+	l := NewDoublyLinkedListWithPool[int](pool)
+	for i := range 3 {
+		l.AddLast(i)
+	}
+	if got := len(pool.chunk); got != 1 {
+		t.Fatalf("nodes left in the chunk = %v, want 1", got)
+	}
+	// a given back node is reused before the rest of the chunk
+	n := l.head
+	l.RemoveFirst()
+	l.AddLast(3)
+	if l.tail != n || len(pool.chunk) != 1 {
+		t.Errorf("AddLast() did not reuse the removed node")
+	}
+	l.AddLast(4).AddLast(5)
+	if got := len(pool.chunk); got != 3 {
+		t.Errorf("nodes left in the new chunk = %v, want 3", got)
+	}
+	checkEnds[int](t, l, []int{1, 2, 3, 4, 5})
+	if got := NewArenaPool[int](0).(*arenaPool[int]).chunkSize; got != 64 {
+		t.Errorf("default chunkSize = %v, want 64", got)
+	}
+}
+
+// BenchmarkNodePool_QueueChurn measures AddLast then RemoveFirst on a list of 1000 elements used as a queue.
+// With a pool, the node removed from the head is reused for the element added to the tail: 0 allocs/op.
+func BenchmarkNodePool_QueueChurn(b *testing.B) {
+	for name, newList := range poolLists() {
+		for poolName, newPool := range nodePools() {
+			b.Run(fmt.Sprintf("%v/pool=%v", name, poolName), func(b *testing.B) {
+				l := newList(newPool())
+				for i := range 1000 {
+					l.AddLast(i)
+				}
+				b.ReportAllocs()
+				for i := 0; b.Loop(); i++ {
+					l.AddLast(i)
+					l.RemoveFirst()
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkNodePool_Fill measures adding 1000 elements to an empty list, then clearing it.
+// Starting from an empty pool, each element allocates a node, except with the arena which allocates
+// the nodes in chunks (of 4 nodes here, so 4 times fewer allocations).
+func BenchmarkNodePool_Fill(b *testing.B) {
+	for name, newList := range poolLists() {
+		for poolName, newPool := range nodePools() {
+			b.Run(fmt.Sprintf("%v/pool=%v", name, poolName), func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					// a fresh pool each time shows the allocations of an empty pool
+					l := newList(newPool())
+					for i := range 1000 {
+						l.AddLast(i)
+					}
+					l.Clear()
+				}
+			})
+		}
+	}
+}
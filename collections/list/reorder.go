@@ -0,0 +1,202 @@
+package list
+
+// In-place reordering of the list types: Reverse, Rotate and Swap relink the nodes instead of copying the values,
+// so the handles of a DoublyLinkedList and a CircularLinkedList follow their elements.
+// They are structural modifications: the iterators in progress fail with ErrConcurrentModification.
+
+// SinglyLinkedList reordering.
+
+// Reverse reverses the order of the elements of the list in place, in O(n) time.
+func (s *SinglyLinkedList[T]) Reverse() {
+	if s.Len() < 2 {
+		return
+	}
+	var prev *singlyLinkedNode[T]
+	for cur := s.head; cur != nil; {
+		next := cur.next
+		cur.next, prev, cur = prev, cur, next
+	}
+	s.head, s.tail = s.tail, s.head
+	s.modCount++
+}
+
+// Rotate rotates the elements of the list k positions to the right, so the element at index i moves to the index
+// (i + k) mod Len(): the last k elements become the first ones. A negative k rotates to the left.
+// The nodes are relinked in O(n) time, as the new tail has to be found from the head.
+func (s *SinglyLinkedList[T]) Rotate(k int) {
+	k = rotation(k, s.Len())
+	if k == 0 {
+		return
+	}
+	newTail := s.nodeAt(s.Len() - k - 1)
+	s.tail.next = s.head
+	s.head, s.tail = newTail.next, newTail
+	newTail.next = nil
+	s.modCount++
+}
+
+// Swap swaps the elements at the indexes i and j by relinking their nodes.
+// An [ErrIndexOutOfBounds] error is returned if either index is less than 0 or not less than the length of the list.
+func (s *SinglyLinkedList[T]) Swap(i, j int) error {
+	if err := checkSwap(i, j, s.Len()); err != nil || i == j {
+		return err
+	}
+	i, j = min(i, j), max(i, j)
+	var predA *singlyLinkedNode[T]
+	a := s.head
+	for range i {
+		predA, a = a, a.next
+	}
+	predB, b := predA, a
+	for range j - i {
+		predB, b = b, b.next
+	}
+
+	if predB == a {
+		// adjacent nodes: a -> b becomes b -> a
+		a.next, b.next = b.next, a
+	} else {
+		a.next, b.next = b.next, a.next
+		predB.next = a
+	}
+	if predA == nil {
+		s.head = b
+	} else {
+		predA.next = b
+	}
+	if s.tail == b {
+		s.tail = a
+	}
+	s.modCount++
+	return nil
+}
+
+// DoublyLinkedList reordering.
+
+// Reverse reverses the order of the elements of the list in place, in O(n) time.
+func (d *DoublyLinkedList[T]) Reverse() {
+	if d.Len() < 2 {
+		return
+	}
+	d.unshare()
+	for cur := d.head; cur != nil; cur = cur.prev {
+		cur.next, cur.prev = cur.prev, cur.next
+	}
+	d.head, d.tail = d.tail, d.head
+	d.modCount++
+}
+
+// Rotate rotates the elements of the list k positions to the right, so the element at index i moves to the index
+// (i + k) mod Len(): the last k elements become the first ones. A negative k rotates to the left.
+// The nodes are relinked in O(min(k, Len()-k)) time, the time to find the new head from the nearer end.
+func (d *DoublyLinkedList[T]) Rotate(k int) {
+	k = rotation(k, d.Len())
+	if k == 0 {
+		return
+	}
+	d.unshare()
+	newHead := d.nodeAt(d.Len() - k)
+	newTail := newHead.prev
+	d.tail.next, d.head.prev = d.head, d.tail
+	newTail.next, newHead.prev = nil, nil
+	d.head, d.tail = newHead, newTail
+	d.modCount++
+}
+
+// Swap swaps the elements at the indexes i and j by relinking their nodes; their handles follow them.
+// An [ErrIndexOutOfBounds] error is returned if either index is less than 0 or not less than the length of the list.
+func (d *DoublyLinkedList[T]) Swap(i, j int) error {
+	if err := checkSwap(i, j, d.Len()); err != nil || i == j {
+		return err
+	}
+	d.unshare()
+	a, b := d.nodeAt(min(i, j)), d.nodeAt(max(i, j))
+	if a.next == b {
+		d.unlink(a)
+		d.linkNodeAfter(a, b)
+		return nil
+	}
+	predA, predB := a.prev, b.prev
+	d.unlink(a)
+	d.linkNodeAfter(a, predB)
+	d.unlink(b)
+	d.linkNodeAfter(b, predA)
+	return nil
+}
+
+// CircularLinkedList reordering.
+
+// Reverse reverses the order of the elements of the list in place, in O(n) time.
+// The tail becomes the head.
+func (c *CircularLinkedList[T]) Reverse() {
+	if c.Len() < 2 {
+		return
+	}
+	cur := c.head
+	for range c.Len() {
+		cur.next, cur.prev = cur.prev, cur.next
+		cur = cur.prev
+	}
+	c.head, c.tail = c.tail, c.head
+	c.modCount++
+}
+
+// Rotate rotates the elements of the list k positions to the right, so the element at index i moves to the index
+// (i + k) mod Len(): the last k elements become the first ones. A negative k rotates to the left.
+// The ring itself is unchanged, only the head and the tail are re-pointed,
+// once the new head is found in O(min(k, Len()-k)) time from the nearer end.
+func (c *CircularLinkedList[T]) Rotate(k int) {
+	k = rotation(k, c.Len())
+	if k == 0 {
+		return
+	}
+	c.head = c.nodeAt(c.Len() - k)
+	c.tail = c.head.prev
+	c.modCount++
+}
+
+// Swap swaps the elements at the indexes i and j, starting from the head, by relinking their nodes;
+// their handles follow them.
+// An [ErrIndexOutOfBounds] error is returned if either index is less than 0 or not less than the length of the list.
+func (c *CircularLinkedList[T]) Swap(i, j int) error {
+	if err := checkSwap(i, j, c.Len()); err != nil || i == j {
+		return err
+	}
+	a, b := c.nodeAt(min(i, j)), c.nodeAt(max(i, j))
+	if a.next == b {
+		c.unlink(a)
+		c.linkNodeAfter(a, b)
+		return nil
+	}
+	// linkNodeAfter takes nil, not the tail, as the position before the head
+	var predA *doublyLinkedNode[T]
+	if a != c.head {
+		predA = a.prev
+	}
+	predB := b.prev
+	c.unlink(a)
+	c.linkNodeAfter(a, predB)
+	c.unlink(b)
+	c.linkNodeAfter(b, predA)
+	return nil
+}
+
+// rotation returns the rotation to the right of a list of length n equivalent to k, in [0, n).
+func rotation(k, n int) int {
+	if n == 0 {
+		return 0
+	}
+	return (k%n + n) % n
+}
+
+// checkSwap returns an [ErrIndexOutOfBounds] error for the first of the indexes i and j
+// that is not valid in a list of the given length.
+func checkSwap(i, j, len int) error {
+	switch {
+	case i < 0 || i >= len:
+		return errIndexOutOfBounds(i, len)
+	case j < 0 || j >= len:
+		return errIndexOutOfBounds(j, len)
+	}
+	return nil
+}
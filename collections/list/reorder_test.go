@@ -0,0 +1,136 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// reorderList is the in-place reordering API shared by the list types.
+type reorderList[T any] interface {
+	LinkedList[T]
+	Reverse()
+	Rotate(k int)
+	Swap(i, j int) error
+}
+
+func TestLinkedList_Reverse(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		for _, values := range [][]int{{}, {1}, {1, 2}, {1, 2, 3}, {1, 2, 3, 4, 5, 6}} {
+			t.Run(fmt.Sprintf("%v/%v", lt, values), func(t *testing.T) {
+				l := NewLinkedListFromSlice(lt, values).(reorderList[int])
+				l.Reverse()
+				want := slices.Clone(values)
+				slices.Reverse(want)
+				checkEnds(t, l, want)
+				l.AddLast(9).AddFirst(0)
+				checkEnds(t, l, append(append([]int{0}, want...), 9))
+				l.Reverse()
+				slices.Reverse(want)
+				checkEnds(t, l, append(append([]int{9}, want...), 0))
+			})
+		}
+	}
+}
+
+func TestLinkedList_Rotate(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4}
+	for _, lt := range allLinkedListTypes {
+		for _, k := range []int{0, 1, 2, 4, 5, 7, -1, -3, -10} {
+			t.Run(fmt.Sprintf("%v/%v", lt, k), func(t *testing.T) {
+				l := NewLinkedListFromSlice(lt, values).(reorderList[int])
+				l.Rotate(k)
+				want := make([]int, len(values))
+				for i, v := range values {
+					want[((i+k)%len(values)+len(values))%len(values)] = v
+				}
+				checkEnds(t, l, want)
+				l.AddLast(9)
+				checkEnds(t, l, append(want, 9))
+			})
+		}
+		t.Run(lt.String()+"/short lists", func(t *testing.T) {
+			l := NewLinkedList[int](lt).(reorderList[int])
+			l.Rotate(3)
+			checkEnds(t, l, []int{})
+			l.AddLast(1)
+			l.Rotate(-2)
+			checkEnds(t, l, []int{1})
+			l.AddLast(2)
+			l.Rotate(1)
+			checkEnds(t, l, []int{2, 1})
+		})
+	}
+}
+
+func TestLinkedList_Swap(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4}
+	for _, lt := range allLinkedListTypes {
+		for i := range values {
+			for j := range values {
+				t.Run(fmt.Sprintf("%v/%v-%v", lt, i, j), func(t *testing.T) {
+					l := NewLinkedListFromSlice(lt, values).(reorderList[int])
+					if err := l.Swap(i, j); err != nil {
+						t.Fatalf("Swap(%v, %v) error = %v", i, j, err)
+					}
+					want := slices.Clone(values)
+					want[i], want[j] = want[j], want[i]
+					checkEnds(t, l, want)
+					l.AddLast(9).AddFirst(8)
+					checkEnds(t, l, append(append([]int{8}, want...), 9))
+				})
+			}
+		}
+		t.Run(lt.String()+"/two elements", func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 1, 2).(reorderList[int])
+			l.Swap(1, 0)
+			checkEnds(t, l, []int{2, 1})
+		})
+		t.Run(lt.String()+"/out of bounds", func(t *testing.T) {
+			l := NewLinkedListFromSlice(lt, values).(reorderList[int])
+			for _, ij := range [][2]int{{-1, 0}, {0, 5}, {5, 5}} {
+				if err := l.Swap(ij[0], ij[1]); !errors.Is(err, ErrIndexOutOfBounds) {
+					t.Errorf("Swap(%v, %v) error = %v, want %v", ij[0], ij[1], err, ErrIndexOutOfBounds)
+				}
+			}
+			checkEnds(t, l, values)
+		})
+	}
+}
+
+func TestLinkedList_SwapHandles(t *testing.T) {
+	for name, newList := range handleLists[string]() {
+		t.Run(name, func(t *testing.T) {
+			l := newList()
+			a := l.AddLastHandle("a")
+			l.AddLastHandle("b")
+			c := l.AddLastHandle("c")
+			if err := l.(reorderList[string]).Swap(0, 2); err != nil {
+				t.Fatalf("Swap() error = %v", err)
+			}
+			checkEnds[string](t, l, []string{"c", "b", "a"})
+			// the handles follow their elements
+			if err := l.MoveToBack(c); err != nil {
+				t.Errorf("MoveToBack(c) error = %v", err)
+			}
+			if v, err := l.Remove(a); err != nil || v != "a" {
+				t.Errorf("Remove(a) = %v, %v, want a, nil", v, err)
+			}
+			checkEnds[string](t, l, []string{"b", "c"})
+		})
+	}
+}
+
+func TestLinkedList_ReorderFailFast(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 1, 2, 3).(reorderList[int])
+			expectConcurrentModification(t, func() {
+				for range l.All() {
+					l.Reverse()
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package list
+
+import "iter"
+
+// IndexOf returns the index of the first occurrence of v in the list, or -1 if v is not present.
+func IndexOf[T comparable](l LinkedList[T], v T) int {
+	return IndexFunc(l, func(e T) bool { return e == v })
+}
+
+// LastIndexOf returns the index of the last occurrence of v in the list, or -1 if v is not present.
+// The list is searched from the tail with [LinkedList.ReverseAll],
+// so on a [DoublyLinkedList] or a [CircularLinkedList] it stops at the last occurrence without visiting the nodes before it.
+func LastIndexOf[T comparable](l LinkedList[T], v T) int {
+	return LastIndexFunc(l, func(e T) bool { return e == v })
+}
+
+// Contains returns true if v is present in the list.
+func Contains[T comparable](l LinkedList[T], v T) bool {
+	return IndexOf(l, v) >= 0
+}
+
+// IndexFunc returns the index of the first element of the list satisfying pred, or -1 if none does.
+func IndexFunc[T any](l LinkedList[T], pred func(T) bool) int {
+	for i, e := range l.All() {
+		if pred(e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexFunc returns the index of the last element of the list satisfying pred, or -1 if none does.
+// Like [LastIndexOf], the list is searched from the tail.
+func LastIndexFunc[T any](l LinkedList[T], pred func(T) bool) int {
+	for i, e := range l.ReverseAll() {
+		if pred(e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Equal returns true if both lists have the same length and hold equal elements in the same order.
+// The implementations of the lists do not have to be the same.
+func Equal[T comparable](l1, l2 LinkedList[T]) bool {
+	return EqualFunc(l1, l2, func(e1, e2 T) bool { return e1 == e2 })
+}
+
+// EqualFunc returns true if both lists have the same length and eq returns true for each pair of elements
+// at the same index. The elements are compared from the head, and the comparison stops at the first mismatch.
+func EqualFunc[T, U any](l1 LinkedList[T], l2 LinkedList[U], eq func(T, U) bool) bool {
+	if l1.Len() != l2.Len() {
+		return false
+	}
+	next, stop := iter.Pull(l2.Values())
+	defer stop()
+	for e1 := range l1.Values() {
+		e2, ok := next()
+		if !ok || !eq(e1, e2) {
+			return false
+		}
+	}
+	_, more := next()
+	return !more
+}
+
+// RemoveFirstOccurrence removes the first occurrence of v from the list and returns true,
+// or returns false if v is not present.
+// The list types of this package unlink the node during the search, in a single pass.
+func RemoveFirstOccurrence[T comparable](l LinkedList[T], v T) bool {
+	return removeFunc(l, func(e T) bool { return e == v }, true) == 1
+}
+
+// RemoveIf removes all the elements of the list satisfying pred and returns the number of removed elements.
+// The list types of this package unlink the matching nodes in a single pass.
+// pred must not modify the list.
+func RemoveIf[T any](l LinkedList[T], pred func(T) bool) int {
+	return removeFunc(l, pred, false)
+}
+
+// predicateRemover is implemented by the lists that can unlink the elements satisfying a predicate
+// in a single pass.
+type predicateRemover[T any] interface {
+	// removeFunc removes the elements satisfying pred, only the first one if firstOnly,
+	// and returns the number of removed elements.
+	removeFunc(pred func(T) bool, firstOnly bool) int
+}
+
+// removeFunc removes the elements of the list satisfying pred, only the first one if firstOnly.
+// Lists that are not a predicateRemover are searched once, then the matching elements are removed by index.
+func removeFunc[T any](l LinkedList[T], pred func(T) bool, firstOnly bool) int {
+	if r, ok := l.(predicateRemover[T]); ok {
+		return r.removeFunc(pred, firstOnly)
+	}
+	var matches []int
+	for i, e := range l.All() {
+		if pred(e) {
+			matches = append(matches, i)
+			if firstOnly {
+				break
+			}
+		}
+	}
+	// remove from the tail so the indexes of the remaining matches are unchanged
+	for i := len(matches) - 1; i >= 0; i-- {
+		l.RemoveAt(matches[i])
+	}
+	return len(matches)
+}
+
+func (s *SinglyLinkedList[T]) removeFunc(pred func(T) bool, firstOnly bool) int {
+	removed := 0
+	var prev *singlyLinkedNode[T]
+	for cur := s.head; cur != nil; {
+		next := cur.next
+		if !pred(cur.value) {
+			prev, cur = cur, next
+			continue
+		}
+		s.unlinkAfter(prev, cur)
+		removed++
+		if firstOnly {
+			break
+		}
+		cur = next
+	}
+	return removed
+}
+
+func (d *DoublyLinkedList[T]) removeFunc(pred func(T) bool, firstOnly bool) int {
+	removed := 0
+	for cur := d.head; cur != nil; {
+		next := cur.next
+		if pred(cur.value) {
+			// copy the nodes shared with a snapshot only once there is something to remove
+			d.unshare(&cur, &next)
+			d.remove(cur)
+			removed++
+			if firstOnly {
+				break
+			}
+		}
+		cur = next
+	}
+	return removed
+}
+
+func (c *CircularLinkedList[T]) removeFunc(pred func(T) bool, firstOnly bool) int {
+	removed := 0
+	// visit every node once; next is taken before unlinking, as unlink clears the links of the node
+	for i, n, cur := 0, c.Len(), c.head; i < n; i++ {
+		next := cur.next
+		if pred(cur.value) {
+			c.remove(cur)
+			removed++
+			if firstOnly {
+				break
+			}
+		}
+		cur = next
+	}
+	return removed
+}
+
+func (s *SynchronizedList[T]) removeFunc(pred func(T) bool, firstOnly bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return removeFunc(s.list, pred, firstOnly)
+}
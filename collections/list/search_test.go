@@ -0,0 +1,172 @@
+package list
+
+import (
+	"strconv"
+	"testing"
+)
+
+// opaqueList hides the concrete type of a list, to test the fallbacks for unknown implementations.
+type opaqueList[T any] struct {
+	LinkedList[T]
+}
+
+// searchableLists returns a list of every implementation holding the given values.
+func searchableLists[T any](values ...T) map[string]LinkedList[T] {
+	lists := make(map[string]LinkedList[T])
+	for _, lt := range allLinkedListTypes {
+		lists[lt.String()] = NewLinkedListFromSlice(lt, values)
+	}
+	lists["SYNCHRONIZED"] = Synchronized(NewLinkedListFromSlice(SinglyLinked, values))
+	lists["OPAQUE"] = opaqueList[T]{NewLinkedListFromSlice(DoublyLinked, values)}
+	return lists
+}
+
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []string
+		v         string
+		index     int
+		lastIndex int
+	}{
+		{"empty", nil, "a", -1, -1},
+		{"absent", []string{"a", "b"}, "c", -1, -1},
+		{"one element", []string{"a"}, "a", 0, 0},
+		{"head", []string{"a", "b", "c"}, "a", 0, 0},
+		{"tail", []string{"a", "b", "c"}, "c", 2, 2},
+		{"repeated", []string{"b", "a", "b", "c", "b", "d"}, "b", 0, 4},
+	}
+	for _, tt := range tests {
+		for name, l := range searchableLists(tt.values...) {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				if got := IndexOf(l, tt.v); got != tt.index {
+					t.Errorf("IndexOf(%v) = %v, want %v", tt.v, got, tt.index)
+				}
+				if got := LastIndexOf(l, tt.v); got != tt.lastIndex {
+					t.Errorf("LastIndexOf(%v) = %v, want %v", tt.v, got, tt.lastIndex)
+				}
+				if got := Contains(l, tt.v); got != (tt.index >= 0) {
+					t.Errorf("Contains(%v) = %v, want %v", tt.v, got, tt.index >= 0)
+				}
+			})
+		}
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	for name, l := range searchableLists(1, 4, 6, 3, 8) {
+		t.Run(name, func(t *testing.T) {
+			if got := IndexFunc(l, isEven); got != 1 {
+				t.Errorf("IndexFunc(isEven) = %v, want 1", got)
+			}
+			if got := LastIndexFunc(l, isEven); got != 4 {
+				t.Errorf("LastIndexFunc(isEven) = %v, want 4", got)
+			}
+			negative := func(n int) bool { return n < 0 }
+			if got := IndexFunc(l, negative); got != -1 {
+				t.Errorf("IndexFunc(negative) = %v, want -1", got)
+			}
+			if got := LastIndexFunc(l, negative); got != -1 {
+				t.Errorf("LastIndexFunc(negative) = %v, want -1", got)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		l1, l2 []int
+		want   bool
+	}{
+		{"both empty", nil, nil, true},
+		{"same", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different length", []int{1, 2}, []int{1, 2, 3}, false},
+		{"different element", []int{1, 2, 3}, []int{1, 5, 3}, false},
+		{"different order", []int{1, 2}, []int{2, 1}, false},
+	}
+	for _, tt := range tests {
+		for _, lt1 := range allLinkedListTypes {
+			for _, lt2 := range allLinkedListTypes {
+				t.Run(tt.name+"/"+lt1.String()+"/"+lt2.String(), func(t *testing.T) {
+					l1, l2 := NewLinkedListFromSlice(lt1, tt.l1), NewLinkedListFromSlice(lt2, tt.l2)
+					if got := Equal(l1, l2); got != tt.want {
+						t.Errorf("Equal(%v, %v) = %v, want %v", tt.l1, tt.l2, got, tt.want)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	ints := NewLinkedListFrom(DoublyLinked, 1, 2, 3)
+	strs := NewLinkedListFrom(SinglyLinked, "1", "2", "3")
+	eq := func(n int, s string) bool { return strconv.Itoa(n) == s }
+	if !EqualFunc(ints, strs, eq) {
+		t.Errorf("EqualFunc(%v, %v) = false, want true", ints, strs)
+	}
+	compared := 0
+	strs.AddFirst("0")
+	ints.AddFirst(9)
+	if EqualFunc(ints, strs, func(n int, s string) bool { compared++; return eq(n, s) }) || compared != 1 {
+		t.Errorf("EqualFunc() = true or compared %v pairs, want false after 1 comparison", compared)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		want    []int
+		removed int
+	}{
+		{"empty", []int{}, []int{}, 0},
+		{"none", []int{1, 3, 5}, []int{1, 3, 5}, 0},
+		{"all", []int{2, 4, 6}, []int{}, 3},
+		{"one element", []int{2}, []int{}, 1},
+		{"head and tail", []int{2, 1, 3, 4}, []int{1, 3}, 2},
+		{"consecutive", []int{1, 2, 4, 6, 3, 8}, []int{1, 3}, 4},
+		{"all but one", []int{2, 4, 5, 6}, []int{5}, 3},
+	}
+	for _, tt := range tests {
+		for name, l := range searchableLists(tt.values...) {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				if got := RemoveIf(l, isEven); got != tt.removed {
+					t.Errorf("RemoveIf(isEven) = %v, want %v", got, tt.removed)
+				}
+				checkEnds(t, l, tt.want)
+				// the list is still usable at both ends
+				l.AddLast(10).AddFirst(0)
+				checkEnds(t, l, append(append([]int{0}, tt.want...), 10))
+			})
+		}
+	}
+}
+
+func TestRemoveFirstOccurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		v       string
+		want    []string
+		removed bool
+	}{
+		{"empty", []string{}, "a", []string{}, false},
+		{"absent", []string{"a", "b"}, "c", []string{"a", "b"}, false},
+		{"one element", []string{"a"}, "a", []string{}, true},
+		{"head", []string{"a", "b", "a"}, "a", []string{"b", "a"}, true},
+		{"middle", []string{"a", "b", "c", "b"}, "b", []string{"a", "c", "b"}, true},
+		{"tail", []string{"a", "b", "c"}, "c", []string{"a", "b"}, true},
+	}
+	for _, tt := range tests {
+		for name, l := range searchableLists(tt.values...) {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				if got := RemoveFirstOccurrence(l, tt.v); got != tt.removed {
+					t.Errorf("RemoveFirstOccurrence(%v) = %v, want %v", tt.v, got, tt.removed)
+				}
+				checkEnds(t, l, tt.want)
+			})
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package list
+
+import "iter"
+
+// Strategy reorders a [SelfOrganizingList] when an element is found, so that the elements searched often
+// end up near the front of the list, where they are found faster.
+// The strategies of this package are [MoveToFrontStrategy], [TransposeStrategy] and [FrequencyCountStrategy];
+// other strategies can be plugged in by implementing this interface.
+type Strategy[T any] interface {
+	// Accessed is called by [SelfOrganizingList.Find] with the handle of the element it found,
+	// to move it within list with the handle methods of [DoublyLinkedList].
+	Accessed(list *DoublyLinkedList[T], h Handle[T])
+	// Removing is called with the handle of an element about to be removed from the list,
+	// to forget anything kept about it.
+	Removing(h Handle[T])
+}
+
+// MoveToFrontStrategy returns a [Strategy] moving the found element to the front of the list.
+// It adapts quickly to a change of the elements searched often, at the cost of moving a rarely searched element
+// all the way to the front when it is found.
+func MoveToFrontStrategy[T any]() Strategy[T] {
+	return moveToFront[T]{}
+}
+
+// TransposeStrategy returns a [Strategy] swapping the found element with the element preceding it.
+// The elements move forward one step per search, so the order converges more slowly than with
+// [MoveToFrontStrategy] but is not disturbed by the occasional search of a rare element.
+func TransposeStrategy[T any]() Strategy[T] {
+	return transpose[T]{}
+}
+
+// FrequencyCountStrategy returns a [Strategy] counting the searches of every element and keeping the elements
+// ordered by decreasing count, the elements with the same count in the order they reached it.
+// It remembers the first element of every count, so a found element moves in O(1) time, right before
+// the first element of its previous count. It takes memory for the counts, and a returned Strategy
+// must not be shared by several lists.
+func FrequencyCountStrategy[T any]() Strategy[T] {
+	return &frequencyCount[T]{counts: make(map[Handle[T]]int), first: make(map[int]Handle[T])}
+}
+
+type moveToFront[T any] struct{}
+
+func (moveToFront[T]) Accessed(list *DoublyLinkedList[T], h Handle[T]) {
+	list.MoveToFront(h)
+}
+
+func (moveToFront[T]) Removing(Handle[T]) {}
+
+type transpose[T any] struct{}
+
+func (transpose[T]) Accessed(list *DoublyLinkedList[T], h Handle[T]) {
+	if prev, ok := h.Prev(); ok {
+		list.MoveBefore(h, prev)
+	}
+}
+
+func (transpose[T]) Removing(Handle[T]) {}
+
+// frequencyCount keeps the elements searched at least once at the front of the list, by decreasing count,
+// followed by the elements never searched. The elements of a count form a run of the list starting at first[count].
+type frequencyCount[T any] struct {
+	counts map[Handle[T]]int // number of searches of every element searched at least once
+	first  map[int]Handle[T] // first element of every count of at least 1 that some element has
+	last   Handle[T]         // last element searched at least once, the zero Handle if there is none
+}
+
+func (f *frequencyCount[T]) Accessed(list *DoublyLinkedList[T], h Handle[T]) {
+	count := f.counts[h]
+	// h leaves the run of its count for the end of the run of count+1, which is right before the run it leaves
+	switch {
+	case count == 0 && f.last == (Handle[T]{}):
+		list.MoveToFront(h)
+		f.last = h
+	case count == 0:
+		list.MoveAfter(h, f.last)
+		f.last = h
+	case f.first[count] == h:
+		// h is already after the runs of the higher counts
+		if next, ok := h.Next(); ok && f.counts[next] == count {
+			f.first[count] = next
+		} else {
+			delete(f.first, count)
+		}
+	default:
+		if f.last == h {
+			f.last, _ = h.Prev()
+		}
+		list.MoveBefore(h, f.first[count])
+	}
+	f.counts[h] = count + 1
+	if _, ok := f.first[count+1]; !ok {
+		f.first[count+1] = h
+	}
+}
+
+func (f *frequencyCount[T]) Removing(h Handle[T]) {
+	count, ok := f.counts[h]
+	if !ok {
+		return
+	}
+	if f.first[count] == h {
+		if next, ok := h.Next(); ok && f.counts[next] == count {
+			f.first[count] = next
+		} else {
+			delete(f.first, count)
+		}
+	}
+	if f.last == h {
+		f.last, _ = h.Prev()
+	}
+	delete(f.counts, h)
+}
+
+// SearchStats are the statistics of the searches of a [SelfOrganizingList].
+type SearchStats struct {
+	Searches uint64 // number of Find calls
+	Hits     uint64 // number of Find calls that found an element
+	Depth    uint64 // total number of elements compared by the Find calls
+}
+
+// AverageDepth returns the average number of elements compared by a Find call, or 0 if Find was never called.
+func (s SearchStats) AverageDepth() float64 {
+	if s.Searches == 0 {
+		return 0
+	}
+	return float64(s.Depth) / float64(s.Searches)
+}
+
+// SelfOrganizingList is a list searched linearly, which reorders its elements as they are found
+// according to a [Strategy], to speed up the searches of lookup tables with a skewed access pattern.
+// Its elements are held by a [DoublyLinkedList], so the strategies of this package reorder an element in O(1) time.
+//
+// [SelfOrganizingList.Stats] reports the average number of elements compared per search,
+// to compare the strategies on a given workload. SelfOrganizingList implements [ReadOnlyList];
+// its read methods (ex: Get, All) do not reorder the list nor count as searches.
+// Ex:
+//
+//	codes := NewSelfOrganizingList(MoveToFrontStrategy[string](), "fr", "de", "it")
+//	codes.Find(func(c string) bool { return c == "it" }) // "it", true
+//	codes.ToSlice()                                    // [it fr de]
+//	codes.Stats().AverageDepth()                       // 3
+type SelfOrganizingList[T any] struct {
+	list     DoublyLinkedList[T]
+	strategy Strategy[T]
+	stats    SearchStats
+}
+
+// NewSelfOrganizingList returns a [SelfOrganizingList] holding the given elements, in order, reordered by strategy.
+// If strategy is nil, [MoveToFrontStrategy] is used.
+func NewSelfOrganizingList[T any](strategy Strategy[T], elements ...T) *SelfOrganizingList[T] {
+	if strategy == nil {
+		strategy = MoveToFrontStrategy[T]()
+	}
+	s := &SelfOrganizingList[T]{strategy: strategy}
+	for _, e := range elements {
+		s.Add(e)
+	}
+	return s
+}
+
+// Add adds e to the end of the list, where the elements not searched yet belong.
+func (s *SelfOrganizingList[T]) Add(e T) {
+	s.list.AddLast(e)
+}
+
+// Find returns the first element satisfying pred and true, after reordering the list according to the strategy,
+// or returns false if no element satisfies pred. Either way the search is counted in the statistics.
+func (s *SelfOrganizingList[T]) Find(pred func(T) bool) (T, bool) {
+	h, depth, found := s.find(pred)
+	s.stats.Searches++
+	s.stats.Depth += uint64(depth)
+	if !found {
+		var zero T
+		return zero, false
+	}
+	s.stats.Hits++
+	s.strategy.Accessed(&s.list, h)
+	return h.Value(), true
+}
+
+// Remove removes the first element satisfying pred and returns it and true,
+// or returns false if no element satisfies pred. It does not count as a search.
+func (s *SelfOrganizingList[T]) Remove(pred func(T) bool) (T, bool) {
+	h, _, found := s.find(pred)
+	if !found {
+		var zero T
+		return zero, false
+	}
+	s.strategy.Removing(h)
+	value, _ := s.list.Remove(h)
+	return value, true
+}
+
+// find returns the handle of the first element satisfying pred, if any, and the number of elements compared.
+func (s *SelfOrganizingList[T]) find(pred func(T) bool) (h Handle[T], depth int, found bool) {
+	h, err := s.list.FirstHandle()
+	for ok := err == nil; ok; h, ok = h.Next() {
+		depth++
+		if pred(h.Value()) {
+			return h, depth, true
+		}
+	}
+	return Handle[T]{}, depth, false
+}
+
+// Stats returns the statistics of the searches since the list was created or the statistics were reset.
+func (s *SelfOrganizingList[T]) Stats() SearchStats {
+	return s.stats
+}
+
+// ResetStats resets the statistics of the searches, without changing the order of the list.
+func (s *SelfOrganizingList[T]) ResetStats() {
+	s.stats = SearchStats{}
+}
+
+func (s *SelfOrganizingList[T]) GetFirst() (T, error) {
+	return s.list.GetFirst()
+}
+
+func (s *SelfOrganizingList[T]) GetLast() (T, error) {
+	return s.list.GetLast()
+}
+
+func (s *SelfOrganizingList[T]) Get(index int) (T, error) {
+	return s.list.Get(index)
+}
+
+func (s *SelfOrganizingList[T]) Len() int {
+	return s.list.Len()
+}
+
+func (s *SelfOrganizingList[T]) IsEmpty() bool {
+	return s.list.IsEmpty()
+}
+
+func (s *SelfOrganizingList[T]) All() iter.Seq2[int, T] {
+	return s.list.All()
+}
+
+func (s *SelfOrganizingList[T]) Values() iter.Seq[T] {
+	return s.list.Values()
+}
+
+func (s *SelfOrganizingList[T]) ReverseAll() iter.Seq2[int, T] {
+	return s.list.ReverseAll()
+}
+
+func (s *SelfOrganizingList[T]) ToSlice() []T {
+	return s.list.ToSlice()
+}
+
+func (s *SelfOrganizingList[T]) String() string {
+	return s.list.String()
+}
@@ -0,0 +1,215 @@
+package list
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func equalTo[T comparable](e T) func(T) bool {
+	return func(v T) bool { return v == e }
+}
+
+func TestSelfOrganizingList_Strategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy Strategy[string]
+		finds    []string
+		want     []string
+	}{
+		{"move to front", MoveToFrontStrategy[string](), []string{"d"}, []string{"d", "a", "b", "c", "e"}},
+		{"move to front twice", MoveToFrontStrategy[string](), []string{"d", "b"}, []string{"b", "d", "a", "c", "e"}},
+		{"move to front head", MoveToFrontStrategy[string](), []string{"a"}, []string{"a", "b", "c", "d", "e"}},
+		{"nil is move to front", nil, []string{"e"}, []string{"e", "a", "b", "c", "d"}},
+		{"transpose", TransposeStrategy[string](), []string{"d"}, []string{"a", "b", "d", "c", "e"}},
+		{"transpose twice", TransposeStrategy[string](), []string{"d", "d"}, []string{"a", "d", "b", "c", "e"}},
+		{"transpose head", TransposeStrategy[string](), []string{"a"}, []string{"a", "b", "c", "d", "e"}},
+		{"count", FrequencyCountStrategy[string](), []string{"d"}, []string{"d", "a", "b", "c", "e"}},
+		// equal counts keep the order they were reached in
+		{"count tie", FrequencyCountStrategy[string](), []string{"d", "c"}, []string{"d", "c", "a", "b", "e"}},
+		{"count overtakes", FrequencyCountStrategy[string](), []string{"d", "c", "c"}, []string{"c", "d", "a", "b", "e"}},
+		{"count stays behind", FrequencyCountStrategy[string](), []string{"b", "b", "e", "e"}, []string{"b", "e", "a", "c", "d"}},
+		{"missing", MoveToFrontStrategy[string](), []string{"z"}, []string{"a", "b", "c", "d", "e"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSelfOrganizingList(tt.strategy, "a", "b", "c", "d", "e")
+			for _, e := range tt.finds {
+				got, ok := s.Find(equalTo(e))
+				if wantOK := e != "z"; ok != wantOK || (ok && got != e) {
+					t.Errorf("Find(%v) = %v, %v, want %v, %v", e, got, ok, e, wantOK)
+				}
+			}
+			checkReadOnlyList(t, s, tt.want)
+		})
+	}
+}
+
+func TestSelfOrganizingList_Stats(t *testing.T) {
+	s := NewSelfOrganizingList(TransposeStrategy[int](), 1, 2, 3, 4)
+	if got := s.Stats().AverageDepth(); got != 0 {
+		t.Errorf("AverageDepth() = %v, want 0 before any search", got)
+	}
+	s.Find(equalTo(3)) // 3 compared, 1 2 3 4 -> 1 3 2 4
+	s.Find(equalTo(3)) // 2 compared, -> 3 1 2 4
+	s.Find(equalTo(9)) // 4 compared
+	s.Get(3)           // not a search
+	want := SearchStats{Searches: 3, Hits: 2, Depth: 9}
+	if got := s.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+	if got := s.Stats().AverageDepth(); got != 3 {
+		t.Errorf("AverageDepth() = %v, want 3", got)
+	}
+
+	if got, ok := s.Remove(equalTo(2)); !ok || got != 2 {
+		t.Errorf("Remove(2) = %v, %v, want 2, true", got, ok)
+	}
+	if s.Stats() != want {
+		t.Errorf("Stats() = %+v after Remove(), want %+v", s.Stats(), want)
+	}
+	checkReadOnlyList(t, s, []int{3, 1, 4})
+
+	s.ResetStats()
+	if got := s.Stats(); got != (SearchStats{}) {
+		t.Errorf("Stats() = %+v after ResetStats(), want zero", got)
+	}
+	checkReadOnlyList(t, s, []int{3, 1, 4})
+}
+
+func TestSelfOrganizingList_Remove(t *testing.T) {
+	s := NewSelfOrganizingList(FrequencyCountStrategy[int](), 1, 2, 3)
+	if _, ok := s.Remove(equalTo(9)); ok {
+		t.Errorf("Remove(9) = _, true, want false")
+	}
+	s.Find(equalTo(3))
+	s.Find(equalTo(3))
+	s.Remove(equalTo(3))
+	// the count of the removed element is forgotten: a new 3 starts from zero
+	s.Add(3)
+	s.Find(equalTo(3))
+	checkReadOnlyList(t, s, []int{3, 1, 2})
+	s.Find(equalTo(2))
+	s.Find(equalTo(2))
+	checkReadOnlyList(t, s, []int{2, 3, 1})
+	if counts := s.strategy.(*frequencyCount[int]).counts; len(counts) != 2 {
+		t.Errorf("the strategy keeps %v counts, want 2", len(counts))
+	}
+
+	empty := NewSelfOrganizingList[int](nil)
+	if _, ok := empty.Find(equalTo(1)); ok {
+		t.Errorf("Find() on an empty list = _, true, want false")
+	}
+	checkReadOnlyList(t, empty, []int{})
+}
+
+// TestSelfOrganizingList_FrequencyCountModel checks the order kept by the frequency count strategy, which moves
+// the found element in O(1), against a slice reordered by walking back over the elements with a lower count.
+func TestSelfOrganizingList_FrequencyCountModel(t *testing.T) {
+	type counted struct{ value, count int }
+	var model []counted
+	s := NewSelfOrganizingList(FrequencyCountStrategy[int]())
+	r := rand.New(rand.NewPCG(3, 4))
+	next := 0
+	for step := range 5_000 {
+		e := r.IntN(next + 1)
+		switch op := r.IntN(10); {
+		case op == 0 || len(model) == 0:
+			s.Add(next)
+			model = append(model, counted{next, 0})
+			next++
+		case op == 1:
+			_, ok := s.Remove(equalTo(e))
+			if i := slices.IndexFunc(model, func(c counted) bool { return c.value == e }); i >= 0 {
+				model = slices.Delete(model, i, i+1)
+			} else if ok {
+				t.Fatalf("step %v: Remove(%v) = _, true, want false", step, e)
+			}
+		default:
+			s.Find(equalTo(e))
+			if i := slices.IndexFunc(model, func(c counted) bool { return c.value == e }); i >= 0 {
+				found := counted{e, model[i].count + 1}
+				j := i
+				for j > 0 && model[j-1].count < found.count {
+					j--
+				}
+				copy(model[j+1:i+1], model[j:i])
+				model[j] = found
+			}
+		}
+		want := make([]int, len(model))
+		for i, c := range model {
+			want[i] = c.value
+		}
+		if got := s.ToSlice(); !slices.Equal(got, want) {
+			t.Fatalf("step %v: ToSlice() = %v, want %v", step, got, want)
+		}
+	}
+}
+
+// moveToBack is a Strategy defined outside of the package strategies, sending the found element to the back.
+type moveToBack[T any] struct {
+	removed []T
+}
+
+func (moveToBack[T]) Accessed(list *DoublyLinkedList[T], h Handle[T]) {
+	list.MoveToBack(h)
+}
+
+func (m *moveToBack[T]) Removing(h Handle[T]) {
+	m.removed = append(m.removed, h.Value())
+}
+
+func TestSelfOrganizingList_CustomStrategy(t *testing.T) {
+	strategy := &moveToBack[int]{}
+	s := NewSelfOrganizingList[int](strategy, 1, 2, 3)
+	s.Find(equalTo(1))
+	checkReadOnlyList(t, s, []int{2, 3, 1})
+	s.Remove(equalTo(3))
+	if !reflect.DeepEqual(strategy.removed, []int{3}) {
+		t.Errorf("Removing() was called with %v, want [3]", strategy.removed)
+	}
+}
+
+// staticStrategy never reorders the list, as a baseline for the other strategies.
+type staticStrategy[T any] struct{}
+
+func (staticStrategy[T]) Accessed(*DoublyLinkedList[T], Handle[T]) {}
+func (staticStrategy[T]) Removing(Handle[T])                       {}
+
+func TestSelfOrganizingList_SkewedWorkload(t *testing.T) {
+	const n, searches = 100, 20_000
+	elements := make([]int, n)
+	for i := range elements {
+		elements[i] = i
+	}
+	// the elements with the highest values are searched the most, and start at the back of the list
+	zipf := rand.NewZipf(rand.New(rand.NewPCG(1, 2)), 1.2, 1, n-1)
+	workload := make([]int, searches)
+	for i := range workload {
+		workload[i] = n - 1 - int(zipf.Uint64())
+	}
+
+	depth := func(strategy Strategy[int]) float64 {
+		s := NewSelfOrganizingList(strategy, elements...)
+		for _, e := range workload {
+			if _, ok := s.Find(equalTo(e)); !ok {
+				t.Fatalf("Find(%v) = _, false", e)
+			}
+		}
+		return s.Stats().AverageDepth()
+	}
+	static := depth(staticStrategy[int]{})
+	for name, strategy := range map[string]Strategy[int]{
+		"move to front": MoveToFrontStrategy[int](),
+		"transpose":     TransposeStrategy[int](),
+		"count":         FrequencyCountStrategy[int](),
+	} {
+		got := depth(strategy)
+		t.Logf("%v: AverageDepth() = %.2f, %.2f without reordering", name, got, static)
+		if got >= static/2 {
+			t.Errorf("%v: AverageDepth() = %v, want less than half of %v without reordering", name, got, static)
+		}
+	}
+}
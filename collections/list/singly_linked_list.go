@@ -14,6 +14,7 @@ import (
 type SinglyLinkedList[T any] struct {
 	head, tail *singlyLinkedNode[T]
 	len        int
+	modCount   int // number of structural modifications, used to detect concurrent modification
 }
 
 // The AddLast method appends the given element to the end of the LinkedList.
@@ -34,6 +35,7 @@ func (s *SinglyLinkedList[T]) AddLast(e T) LinkedList[T] {
 	s.tail.next = newNode
 	s.tail = newNode
 	s.len, newNode = s.len+1, nil
+	s.modCount++
 	return s
 }
 
@@ -47,6 +49,7 @@ func (s *SinglyLinkedList[T]) AddFirst(e T) LinkedList[T] {
 	// make the new node as head
 	s.head = newNode
 	s.len, newNode = s.len+1, nil
+	s.modCount++
 	return s
 }
 
@@ -63,6 +66,7 @@ func (s *SinglyLinkedList[T]) Insert(e T, index int) (bool, error) {
 	case index == 1:
 		newNode := &singlyLinkedNode[T]{e, s.head.next}
 		s.head.next, s.len = newNode, s.len+1
+		s.modCount++
 		newNode = nil // avoid memory leak
 		return true, nil
 	default:
@@ -77,6 +81,7 @@ func (s *SinglyLinkedList[T]) Insert(e T, index int) (bool, error) {
 		}
 		newNode := &singlyLinkedNode[T]{e, cur}
 		prev.next, s.len = newNode, s.len+1
+		s.modCount++
 		return true, nil
 	}
 }
@@ -153,6 +158,7 @@ func (s *SinglyLinkedList[T]) addFirstNode(e T) {
 	s.head = node
 	s.tail = s.head
 	s.len, node = s.len+1, nil
+	s.modCount++
 }
 
 func (s *SinglyLinkedList[T]) RemoveFirst() (T, error) {
@@ -161,11 +167,7 @@ func (s *SinglyLinkedList[T]) RemoveFirst() (T, error) {
 		var t T
 		return t, errNoSuchElement()
 	}
-	head := s.head
-	s.head = head.next
-	ret := head.value
-	s.len, head = s.len-1, nil
-	return ret, nil
+	return s.unlinkAfter(nil, s.head), nil
 }
 
 func (s *SinglyLinkedList[T]) RemoveLast() (T, error) {
@@ -173,17 +175,8 @@ func (s *SinglyLinkedList[T]) RemoveLast() (T, error) {
 		var t T
 		return t, errNoSuchElement()
 	}
-	cur := s.head
-	prev := cur
-	for cur != nil && cur.next != nil {
-		prev = cur
-		cur = cur.next
-	}
-	s.tail = prev
-	s.tail.next = nil // tail's next is nil
-	ret := cur.value
-	s.len, cur = s.len-1, nil
-	return ret, nil
+	// the predecessor of the tail is the node at len-2, or nil (the head) for a single element
+	return s.unlinkAfter(s.nodeAt(s.Len()-2), s.tail), nil
 }
 
 func (s *SinglyLinkedList[T]) RemoveAt(index int) (T, error) {
@@ -205,6 +198,7 @@ func (s *SinglyLinkedList[T]) RemoveAt(index int) (T, error) {
 		rv := cur.value
 		prev.next = cur.next
 		cur.next, cur, s.len = nil, nil, s.len-1
+		s.modCount++
 		return rv, nil
 
 	}
@@ -212,11 +206,13 @@ func (s *SinglyLinkedList[T]) RemoveAt(index int) (T, error) {
 
 func (s *SinglyLinkedList[T]) All() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
+		expectedModCount := s.modCount
 		cur := s.head
 		for i := 0; cur != nil; i++ {
 			if !yield(i, cur.value) {
 				return
 			}
+			s.checkModCount(expectedModCount)
 			cur = cur.next
 		}
 	}
@@ -224,23 +220,27 @@ func (s *SinglyLinkedList[T]) All() iter.Seq2[int, T] {
 
 func (s *SinglyLinkedList[T]) Values() iter.Seq[T] {
 	return func(yield func(T) bool) {
+		expectedModCount := s.modCount
 		cur := s.head
 		for cur != nil {
 			if !yield(cur.value) {
 				return
 			}
+			s.checkModCount(expectedModCount)
 			cur = cur.next
 		}
 	}
 }
 
 func (s *SinglyLinkedList[T]) ReverseAll() iter.Seq2[int, T] {
-	sl := s.ToSlice()
 	return func(yield func(int, T) bool) {
+		expectedModCount := s.modCount
+		sl := s.ToSlice()
 		for i := len(sl) - 1; i >= 0; i-- {
 			if !yield(i, sl[i]) {
 				return
 			}
+			s.checkModCount(expectedModCount)
 		}
 	}
 }
@@ -271,3 +271,65 @@ func (s *SinglyLinkedList[T]) String() string {
 	sb.WriteString("<nil>")
 	return sb.String()
 }
+
+// linkAfter links a new node holding e right after pred and returns the new node.
+// If pred is nil, the new node becomes the head of the list.
+func (s *SinglyLinkedList[T]) linkAfter(e T, pred *singlyLinkedNode[T]) *singlyLinkedNode[T] {
+	newNode := &singlyLinkedNode[T]{value: e}
+	if pred == nil {
+		newNode.next, s.head = s.head, newNode
+	} else {
+		newNode.next, pred.next = pred.next, newNode
+	}
+	if newNode.next == nil {
+		s.tail = newNode
+	}
+	s.len++
+	s.modCount++
+	return newNode
+}
+
+// unlinkAfter removes node n, whose predecessor is pred, from the list and returns its value.
+// pred must be nil if n is the head of the list.
+func (s *SinglyLinkedList[T]) unlinkAfter(pred, n *singlyLinkedNode[T]) T {
+	if pred == nil {
+		s.head = n.next
+	} else {
+		pred.next = n.next
+	}
+	if n == s.tail {
+		s.tail = pred
+	}
+	value := n.value
+	n.next, s.len = nil, s.len-1
+	s.modCount++
+	return value
+}
+
+// nodeAt returns the node at the given index, or nil if the index is out of bounds.
+func (s *SinglyLinkedList[T]) nodeAt(index int) *singlyLinkedNode[T] {
+	if index < 0 || index >= s.Len() {
+		return nil
+	}
+	cur := s.head
+	for i := 0; i < index; i++ {
+		cur = cur.next
+	}
+	return cur
+}
+
+// checkModCount panics with an [ErrConcurrentModification] error
+// if the list was structurally modified since expectedModCount was read.
+func (s *SinglyLinkedList[T]) checkModCount(expectedModCount int) {
+	if s.modCount != expectedModCount {
+		panic(errConcurrentModification())
+	}
+}
+
+func (s *SinglyLinkedList[T]) modifications() int {
+	return s.modCount
+}
+
+func (s *SinglyLinkedList[T]) listType() linkedListType {
+	return SinglyLinked
+}
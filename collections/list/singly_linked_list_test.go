@@ -448,6 +448,32 @@ func TestRemoveLast(t *testing.T) {
 	}
 }
 
+func TestRemoveOnlyElement(t *testing.T) {
+	// removing the only element must reset both the head and the tail,
+	// or the removed node is still reachable from the empty list
+	removers := map[string]func(LinkedList[int]) (int, error){
+		"RemoveFirst": LinkedList[int].RemoveFirst,
+		"RemoveLast":  LinkedList[int].RemoveLast,
+	}
+	for name, remove := range removers {
+		t.Run(name, func(t *testing.T) {
+			list := NewLinkedListFrom(SinglyLinked, 42)
+			if got, err := remove(list); got != 42 || err != nil {
+				t.Fatalf("%v() got = %v, %v expected %v, nil", name, got, err, 42)
+			}
+			if s := list.(*SinglyLinkedList[int]); s.head != nil || s.tail != nil {
+				t.Errorf("%v() left head = %v, tail = %v expected nil, nil", name, s.head, s.tail)
+			}
+			for v := range list.Values() {
+				t.Errorf("Values() yielded %v from an empty list", v)
+			}
+			if got := list.String(); got != "<nil>" {
+				t.Errorf("String() got %q expected %q", got, "<nil>")
+			}
+		})
+	}
+}
+
 func TestRemoveAt(t *testing.T) {
 	list1 := NewLinkedListFrom(SinglyLinked, 2, 3, 4, 5, 6, 7, 8, 1, 12, 24, 23, 54, 90)
 	list2 := NewLinkedListFrom(SinglyLinked, 2, 4, 6, 8)
@@ -0,0 +1,286 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+)
+
+// skipListMaxLevel is the number of levels of a SkipList, enough for 4^32 elements.
+const skipListMaxLevel = 32
+
+// SkipList is a list keeping its elements sorted in ascending order as determined by a comparison function.
+// It is an indexable skip list: besides the links to the next node at each level, every link counts the elements
+// it skips, so that [SkipList.Insert], [SkipList.Delete], [SkipList.Contains], [SkipList.Get] and [SkipList.Rank]
+// take O(log n) time on average, where the linked lists of this package take O(n) time.
+//
+// Equal elements are kept in insertion order. The zero value is not usable, see [NewSkipList].
+// SkipList implements [ReadOnlyList]; like the iterators of the other lists, its iterators are fail-fast.
+// Ex:
+//
+//	scores := NewSkipList(cmp.Compare[int])
+//	scores.Insert(30)
+//	scores.Insert(10)
+//	scores.Insert(20)     // 10, 20, 30
+//	scores.Get(1)         // 20
+//	scores.Rank(25)       // 2
+//	scores.Range(15, 100) // 20, 30
+type SkipList[T any] struct {
+	head     skipListNode[T] // sentinel before the first node, with a link at every level
+	tail     *skipListNode[T]
+	level    int // number of levels in use, at least 1
+	len      int
+	modCount int // number of structural modifications, used to detect concurrent modification
+	cmp      func(a, b T) int
+}
+
+type skipListNode[T any] struct {
+	value T
+	prev  *skipListNode[T] // previous node at level 0, nil for the first node
+	links []skipListLink[T]
+}
+
+// skipListLink is the link of a node to the next node at one level.
+type skipListLink[T any] struct {
+	next *skipListNode[T]
+	span int // number of level 0 steps from the node to next, or to the end of the list if next is nil
+}
+
+// NewSkipList returns an empty [SkipList] ordering its elements with cmp.
+// cmp(a, b) should return a negative number when a < b, a positive number when a > b and zero when a == b
+// (as [cmp.Compare] does for ordered types).
+func NewSkipList[T any](cmp func(a, b T) int) *SkipList[T] {
+	s := &SkipList[T]{level: 1, cmp: cmp}
+	s.head.links = make([]skipListLink[T], skipListMaxLevel)
+	return s
+}
+
+// Insert adds e after the elements less than or equal to it and returns its index.
+func (s *SkipList[T]) Insert(e T) int {
+	var update [skipListMaxLevel]*skipListNode[T]
+	var rank [skipListMaxLevel]int // number of elements before update[i]
+	x := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for next := x.links[i].next; next != nil && s.cmp(next.value, e) <= 0; next = x.links[i].next {
+			rank[i] += x.links[i].span
+			x = next
+		}
+		update[i] = x
+	}
+	level := randomSkipListLevel()
+	for i := s.level; i < level; i++ {
+		rank[i], update[i] = 0, &s.head
+		s.head.links[i].span = s.len
+	}
+	s.level = max(s.level, level)
+
+	n := &skipListNode[T]{value: e, links: make([]skipListLink[T], level)}
+	for i := range level {
+		link := &update[i].links[i]
+		n.links[i] = skipListLink[T]{next: link.next, span: link.span - (rank[0] - rank[i])}
+		link.next, link.span = n, rank[0]-rank[i]+1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].links[i].span++
+	}
+	if update[0] != &s.head {
+		n.prev = update[0]
+	}
+	if next := n.links[0].next; next != nil {
+		next.prev = n
+	} else {
+		s.tail = n
+	}
+	s.len++
+	s.modCount++
+	return rank[0]
+}
+
+// Delete removes the first element equal to e and returns true, or returns false if there is none.
+func (s *SkipList[T]) Delete(e T) bool {
+	var update [skipListMaxLevel]*skipListNode[T]
+	s.findLess(e, &update)
+	x := update[0].links[0].next
+	if x == nil || s.cmp(x.value, e) != 0 {
+		return false
+	}
+	for i := range s.level {
+		link := &update[i].links[i]
+		if link.next == x {
+			link.next, link.span = x.links[i].next, link.span+x.links[i].span-1
+		} else {
+			link.span--
+		}
+	}
+	if next := x.links[0].next; next != nil {
+		next.prev = x.prev
+	} else {
+		s.tail = x.prev
+	}
+	for s.level > 1 && s.head.links[s.level-1].next == nil {
+		s.level--
+	}
+	s.len--
+	s.modCount++
+	return true
+}
+
+// Contains returns true if an element equal to e is in the list.
+func (s *SkipList[T]) Contains(e T) bool {
+	var update [skipListMaxLevel]*skipListNode[T]
+	s.findLess(e, &update)
+	next := update[0].links[0].next
+	return next != nil && s.cmp(next.value, e) == 0
+}
+
+// Rank returns the number of elements less than e, which is the index of the first element equal to e if any,
+// or else the index at which e would be inserted.
+func (s *SkipList[T]) Rank(e T) int {
+	var update [skipListMaxLevel]*skipListNode[T]
+	return s.findLess(e, &update)
+}
+
+// Range returns an iterator over the elements greater than or equal to from and less than to, in ascending order.
+// Finding the first element takes O(log n) time.
+func (s *SkipList[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := s.modCount
+		var update [skipListMaxLevel]*skipListNode[T]
+		s.findLess(from, &update)
+		for cur := update[0].links[0].next; cur != nil && s.cmp(cur.value, to) < 0; cur = cur.links[0].next {
+			if !yield(cur.value) {
+				return
+			}
+			s.checkModCount(expectedModCount)
+		}
+	}
+}
+
+// findLess stores in update the last node less than e at each level, and returns the number of elements less than e.
+func (s *SkipList[T]) findLess(e T, update *[skipListMaxLevel]*skipListNode[T]) int {
+	rank := 0
+	x := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for next := x.links[i].next; next != nil && s.cmp(next.value, e) < 0; next = x.links[i].next {
+			rank += x.links[i].span
+			x = next
+		}
+		update[i] = x
+	}
+	return rank
+}
+
+// randomSkipListLevel returns the level of a new node: 1, with a probability of 1/4 to go one level higher.
+func randomSkipListLevel() int {
+	level := 1
+	for level < skipListMaxLevel && rand.IntN(4) == 0 {
+		level++
+	}
+	return level
+}
+
+func (s *SkipList[T]) GetFirst() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return s.head.links[0].next.value, nil
+}
+
+func (s *SkipList[T]) GetLast() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return s.tail.value, nil
+}
+
+// Get returns the element at the given index in O(log n) time.
+// An [ErrIndexOutOfBounds] error is returned if the index is less than 0 or not less than the length of the list.
+func (s *SkipList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= s.Len() {
+		var zero T
+		return zero, errIndexOutOfBounds(index, s.Len())
+	}
+	// the node at the index is index+1 level 0 steps away from the head
+	traversed, x := 0, &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.links[i].next != nil && traversed+x.links[i].span <= index+1 {
+			traversed += x.links[i].span
+			x = x.links[i].next
+		}
+		if traversed == index+1 {
+			break
+		}
+	}
+	return x.value, nil
+}
+
+func (s *SkipList[T]) Len() int {
+	return s.len
+}
+
+func (s *SkipList[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *SkipList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		expectedModCount := s.modCount
+		cur := s.head.links[0].next
+		for i := 0; cur != nil; i++ {
+			if !yield(i, cur.value) {
+				return
+			}
+			s.checkModCount(expectedModCount)
+			cur = cur.links[0].next
+		}
+	}
+}
+
+func (s *SkipList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SkipList[T]) ReverseAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		expectedModCount := s.modCount
+		cur := s.tail
+		for i := s.Len() - 1; cur != nil; i-- {
+			if !yield(i, cur.value) {
+				return
+			}
+			s.checkModCount(expectedModCount)
+			cur = cur.prev
+		}
+	}
+}
+
+func (s *SkipList[T]) ToSlice() []T {
+	slice := make([]T, 0, s.Len())
+	for v := range s.Values() {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+func (s *SkipList[T]) String() string {
+	return fmt.Sprint(s.ToSlice())
+}
+
+// checkModCount panics with an [ErrConcurrentModification] error
+// if the list was structurally modified since expectedModCount was read.
+func (s *SkipList[T]) checkModCount(expectedModCount int) {
+	if s.modCount != expectedModCount {
+		panic(errConcurrentModification())
+	}
+}
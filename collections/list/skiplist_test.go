@@ -0,0 +1,153 @@
+package list
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkSkipList checks the elements of s, and the spans of its links through Get and Rank.
+func checkSkipList(t *testing.T, s *SkipList[int], want []int) {
+	t.Helper()
+	checkReadOnlyList[int](t, s, want)
+	for i, v := range want {
+		if got, _ := s.Get(i); got != v {
+			t.Fatalf("Get(%v) = %v, want %v", i, got, v)
+		}
+		if got, _ := slices.BinarySearch(want, v); s.Rank(v) != got {
+			t.Fatalf("Rank(%v) = %v, want %v", v, s.Rank(v), got)
+		}
+	}
+}
+
+func TestSkipList_InsertDelete(t *testing.T) {
+	s := NewSkipList(cmp.Compare[int])
+	checkSkipList(t, s, []int{})
+	for i, v := range []int{5, 1, 4, 1, 9} {
+		index := s.Insert(v)
+		if want := []int{0, 0, 1, 1, 4}[i]; index != want {
+			t.Errorf("Insert(%v) = %v, want %v", v, index, want)
+		}
+	}
+	checkSkipList(t, s, []int{1, 1, 4, 5, 9})
+	if !s.Delete(1) || !s.Delete(9) || s.Delete(7) {
+		t.Errorf("Delete() did not remove exactly the present elements")
+	}
+	checkSkipList(t, s, []int{1, 4, 5})
+	if !s.Contains(4) || s.Contains(9) || s.Contains(0) {
+		t.Errorf("Contains() = wrong result")
+	}
+	if _, err := s.Get(3); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("Get(3) error = %v, want %v", err, ErrIndexOutOfBounds)
+	}
+	if _, err := s.Get(-1); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("Get(-1) error = %v, want %v", err, ErrIndexOutOfBounds)
+	}
+	if got := s.String(); got != "[1 4 5]" {
+		t.Errorf("String() = %q, want %q", got, "[1 4 5]")
+	}
+}
+
+func TestSkipList_EqualElementsKeepInsertionOrder(t *testing.T) {
+	type entry struct{ key, seq int }
+	s := NewSkipList(func(a, b entry) int { return cmp.Compare(a.key, b.key) })
+	for seq, key := range []int{2, 1, 2, 1, 2} {
+		s.Insert(entry{key, seq})
+	}
+	want := []entry{{1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 4}}
+	checkReadOnlyList[entry](t, s, want)
+	// Delete removes the first of the equal elements
+	s.Delete(entry{key: 2})
+	checkReadOnlyList[entry](t, s, []entry{{1, 1}, {1, 3}, {2, 2}, {2, 4}})
+}
+
+func TestSkipList_Range(t *testing.T) {
+	s := NewSkipList(cmp.Compare[int])
+	for _, v := range []int{10, 20, 20, 30, 40} {
+		s.Insert(v)
+	}
+	tests := []struct {
+		from, to int
+		want     []int
+	}{
+		{20, 40, []int{20, 20, 30}},
+		{15, 35, []int{20, 20, 30}},
+		{0, 100, []int{10, 20, 20, 30, 40}},
+		{41, 100, nil},
+		{30, 30, nil},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(s.Range(tt.from, tt.to)); !slices.Equal(got, tt.want) {
+			t.Errorf("Range(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+	for v := range s.Range(0, 100) {
+		if v == 20 {
+			break
+		}
+	}
+	expectConcurrentModification(t, func() {
+		for range s.Range(0, 100) {
+			s.Insert(0)
+		}
+	})
+	expectConcurrentModification(t, func() {
+		for range s.All() {
+			s.Delete(0)
+		}
+	})
+}
+
+func TestSkipList_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := NewSkipList(cmp.Compare[int])
+	var want []int
+	for range 2000 {
+		v := r.IntN(100)
+		if r.IntN(3) == 0 {
+			i, found := slices.BinarySearch(want, v)
+			if s.Delete(v) != found {
+				t.Fatalf("Delete(%v) = %v, want %v", v, !found, found)
+			}
+			if found {
+				want = slices.Delete(want, i, i+1)
+			}
+			continue
+		}
+		// insert after the equal elements
+		i, _ := slices.BinarySearch(want, v+1)
+		if index := s.Insert(v); index != i {
+			t.Fatalf("Insert(%v) = %v, want %v", v, index, i)
+		}
+		want = slices.Insert(want, i, v)
+	}
+	checkSkipList(t, s, want)
+	for _, v := range slices.Clone(want) {
+		s.Delete(v)
+	}
+	checkSkipList(t, s, []int{})
+	if s.level != 1 {
+		t.Errorf("level = %v once empty, want 1", s.level)
+	}
+}
+
+// BenchmarkSkipList_Get compares Get at random indexes on a 1M element SkipList and DoublyLinkedList.
+func BenchmarkSkipList_Get(b *testing.B) {
+	s := NewSkipList(cmp.Compare[int])
+	d := &DoublyLinkedList[int]{}
+	for i := range benchmarkListLen {
+		s.Insert(i)
+		d.AddLast(i)
+	}
+	lists := map[string]ReadOnlyList[int]{"SkipList": s, DoublyLinked.String(): d}
+	for name, l := range lists {
+		b.Run(name, func(b *testing.B) {
+			r := rand.New(rand.NewPCG(1, 2))
+			for b.Loop() {
+				l.Get(r.IntN(benchmarkListLen))
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Snapshot is an immutable view of the elements a [DoublyLinkedList] held when its Snapshot method was called.
+// It implements [ReadOnlyList]. As the snapshot is never modified, it can be read, and iterated with All,
+// from other goroutines without locking while the list keeps being modified by its own goroutine.
+type Snapshot[T any] struct {
+	head, tail *doublyLinkedNode[T]
+	len        int
+}
+
+// Snapshot returns an immutable view of the current elements of the list, see [Snapshot]. It takes O(1) time:
+// the snapshot shares the nodes of the list. Adding elements at either end of the list (AddLast, AddFirst, AddAll,
+// AddLastHandle, AddFirstHandle and the Deque methods adding elements) keeps sharing the nodes, as it only links
+// new nodes past the ends of the snapshots, so appending while taking snapshots stays O(1) per element.
+// Any other modification copies the nodes the first time it happens after a Snapshot call (copy on write),
+// leaving the original nodes to the snapshots. That copy is of the whole list, so it takes O(n) time:
+// alternating Snapshot calls and such modifications makes every modification O(n).
+//
+// The handles of the list stay valid through the copy, as every shared node keeps a link to its copy,
+// so the snapshot keeps the nodes of the list it was copied to reachable for as long as the snapshot is.
+// The iterators and views of the list obtained before the copy fail with an [ErrConcurrentModification] error,
+// except for the iterator making the modification.
+// Ex:
+//
+//	snap := l.Snapshot()
+//	go report(snap) // iterates over snap.All()
+//	l.AddLast(e)    // copies the nodes, snap is unchanged
+func (d *DoublyLinkedList[T]) Snapshot() *Snapshot[T] {
+	if !d.IsEmpty() {
+		d.shared = true
+	}
+	return &Snapshot[T]{head: d.head, tail: d.tail, len: d.len}
+}
+
+// unshare gives the list its own copy of the nodes it shares with its snapshots, if any, before it is modified.
+// Every shared node is forwarded to its copy, so that the handles of the list follow it (see latest);
+// the node pointers given as arguments are updated to the copies of the nodes they point to.
+// The forward links are the only fields of the shared nodes that are written, and the snapshots never read them,
+// so the snapshots can be read concurrently. The methods adding elements at either end of the list do not call
+// unshare: they only write the next link of the tail or the prev link of the head, which the snapshots do not read.
+func (d *DoublyLinkedList[T]) unshare(nodes ...**doublyLinkedNode[T]) {
+	if !d.shared {
+		return
+	}
+	d.shared = false
+	owner := d.identity()
+	var head, tail *doublyLinkedNode[T]
+	for cur := d.head; cur != nil; cur = cur.next {
+		n := newNode(d.pool, cur.value)
+		n.prev, n.owner = tail, owner
+		if tail == nil {
+			head = n
+		} else {
+			tail.next = n
+		}
+		tail = n
+		cur.forward = n
+		for _, p := range nodes {
+			if *p == cur {
+				*p = n
+			}
+		}
+	}
+	d.head, d.tail = head, tail
+	d.modCount++
+}
+
+func (s *Snapshot[T]) GetFirst() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return s.head.value, nil
+}
+
+func (s *Snapshot[T]) GetLast() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return s.tail.value, nil
+}
+
+// Get returns the element at the given index, walking from the nearer end of the snapshot.
+func (s *Snapshot[T]) Get(index int) (T, error) {
+	if index < 0 || index >= s.Len() {
+		var zero T
+		return zero, errIndexOutOfBounds(index, s.Len())
+	}
+	if index < s.len/2 {
+		cur := s.head
+		for range index {
+			cur = cur.next
+		}
+		return cur.value, nil
+	}
+	cur := s.tail
+	for range s.len - 1 - index {
+		cur = cur.prev
+	}
+	return cur.value, nil
+}
+
+func (s *Snapshot[T]) Len() int {
+	return s.len
+}
+
+func (s *Snapshot[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// All returns an iterator over the indexes and elements of the snapshot.
+// Unlike the iterators of the lists, it never fails, as the snapshot is never modified.
+func (s *Snapshot[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		// the link after the tail is not read, the list may be appending to it
+		for i, cur := 0, s.head; i < s.len; i++ {
+			if !yield(i, cur.value) || i+1 == s.len {
+				return
+			}
+			cur = cur.next
+		}
+	}
+}
+
+func (s *Snapshot[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Snapshot[T]) ReverseAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		// the link before the head is not read, the list may be prepending to it
+		for i, cur := s.len-1, s.tail; i >= 0; i-- {
+			if !yield(i, cur.value) || i == 0 {
+				return
+			}
+			cur = cur.prev
+		}
+	}
+}
+
+func (s *Snapshot[T]) ToSlice() []T {
+	slice := make([]T, 0, s.Len())
+	for v := range s.Values() {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+func (s *Snapshot[T]) String() string {
+	var sb strings.Builder
+	for i, v := range s.All() {
+		sb.WriteString(fmt.Sprintf("%v", v))
+		if i+1 < s.Len() {
+			sb.WriteString(" <=> ")
+		}
+	}
+	return sb.String()
+}
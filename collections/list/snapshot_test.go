@@ -0,0 +1,303 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestDoublyLinkedList_SnapshotCopyOnWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(l *DoublyLinkedList[int])
+		want   []int
+	}{
+		{"AddLast", func(l *DoublyLinkedList[int]) { l.AddLast(5) }, []int{1, 2, 3, 4, 5}},
+		{"AddFirst", func(l *DoublyLinkedList[int]) { l.AddFirst(0) }, []int{0, 1, 2, 3, 4}},
+		{"Insert", func(l *DoublyLinkedList[int]) { l.Insert(9, 2) }, []int{1, 2, 9, 3, 4}},
+		{"RemoveFirst", func(l *DoublyLinkedList[int]) { l.RemoveFirst() }, []int{2, 3, 4}},
+		{"RemoveLast", func(l *DoublyLinkedList[int]) { l.RemoveLast() }, []int{1, 2, 3}},
+		{"RemoveAt", func(l *DoublyLinkedList[int]) { l.RemoveAt(1) }, []int{1, 3, 4}},
+		{"AddAll", func(l *DoublyLinkedList[int]) { l.AddAll(slices.Values([]int{5, 6})) }, []int{1, 2, 3, 4, 5, 6}},
+		{"InsertAll", func(l *DoublyLinkedList[int]) { l.InsertAll(1, slices.Values([]int{8})) }, []int{1, 8, 2, 3, 4}},
+		{"Clear", func(l *DoublyLinkedList[int]) { l.Clear() }, []int{}},
+		{"Splice", func(l *DoublyLinkedList[int]) {
+			l.Splice(2, NewLinkedListFrom(DoublyLinked, 7, 8).(*DoublyLinkedList[int]))
+		}, []int{1, 2, 7, 8, 3, 4}},
+		{"SplicedInto", func(l *DoublyLinkedList[int]) {
+			other := NewLinkedListFrom(DoublyLinked, 0).(*DoublyLinkedList[int])
+			other.Concat(l)
+		}, []int{}},
+		{"AddFirstHandle", func(l *DoublyLinkedList[int]) { l.AddFirstHandle(0) }, []int{0, 1, 2, 3, 4}},
+		{"Reverse", func(l *DoublyLinkedList[int]) { l.Reverse() }, []int{4, 3, 2, 1}},
+		{"Rotate", func(l *DoublyLinkedList[int]) { l.Rotate(1) }, []int{4, 1, 2, 3}},
+		{"Swap", func(l *DoublyLinkedList[int]) { l.Swap(0, 3) }, []int{4, 2, 3, 1}},
+		{"SortStable", func(l *DoublyLinkedList[int]) { l.SortStable(func(a, b int) int { return b - a }) }, []int{4, 3, 2, 1}},
+		{"RemoveIf", func(l *DoublyLinkedList[int]) { RemoveIf[int](l, func(e int) bool { return e%2 == 0 }) }, []int{1, 3}},
+		{"RemoveRange", func(l *DoublyLinkedList[int]) { l.RemoveRange(1, 3) }, []int{1, 4}},
+		{"SubList", func(l *DoublyLinkedList[int]) {
+			view, _ := l.SubList(1, 3)
+			view.RemoveFirst()
+		}, []int{1, 3, 4}},
+		{"IteratorSet", func(l *DoublyLinkedList[int]) {
+			it := l.ListIterator()
+			it.Next()
+			it.Next()
+			it.Set(20)
+		}, []int{1, 20, 3, 4}},
+		{"IteratorRemove", func(l *DoublyLinkedList[int]) {
+			for it := l.ListIterator(); it.HasNext(); {
+				if v, _ := it.Next(); v%2 == 1 {
+					it.Remove()
+				}
+			}
+		}, []int{2, 4}},
+		{"IteratorInsert", func(l *DoublyLinkedList[int]) {
+			it := l.ListIterator()
+			it.Next()
+			it.InsertAfter(10)
+			it.InsertBefore(0)
+			it.Next()
+			it.InsertAfter(11)
+		}, []int{0, 1, 10, 11, 2, 3, 4}},
+		{"Deque", func(l *DoublyLinkedList[int]) {
+			l.Push(0)
+			l.Poll()
+			l.PollLast()
+		}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLinkedListFrom(DoublyLinked, 1, 2, 3, 4).(*DoublyLinkedList[int])
+			snap := l.Snapshot()
+			tt.modify(l)
+			checkEnds(t, l, tt.want)
+			checkReadOnlyList(t, snap, []int{1, 2, 3, 4})
+		})
+	}
+}
+
+func TestDoublyLinkedList_SnapshotKeepsHandles(t *testing.T) {
+	l := NewLinkedListFrom(DoublyLinked, 1, 2, 3).(*DoublyLinkedList[int])
+	h, _ := l.LastHandle()
+	it := l.ListIterator()
+	it.Next()
+	snap := l.Snapshot()
+
+	// reads do not copy the nodes: the handle and the iterator are still valid
+	if v := h.Value(); v != 3 {
+		t.Errorf("Value() = %v, want 3", v)
+	}
+	if v, err := it.Next(); v != 2 || err != nil {
+		t.Errorf("Next() = %v, %v, want 2", v, err)
+	}
+
+	// the first modification copies the nodes, and the handles taken before follow the copies
+	if err := l.Update(h, 30); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+	if v := h.Value(); v != 30 {
+		t.Errorf("Value() = %v, want 30", v)
+	}
+	l.AddFirst(0)
+	if err := l.MoveToFront(h); err != nil {
+		t.Errorf("MoveToFront() error = %v", err)
+	}
+	if _, err := it.Next(); !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("Next() error = %v, want %v", err, ErrConcurrentModification)
+	}
+	checkEnds(t, l, []int{30, 0, 1, 2})
+	checkReadOnlyList(t, snap, []int{1, 2, 3})
+
+	// a second snapshot shares the new nodes, until the next modification; h follows both copies
+	snap2 := l.Snapshot()
+	if err := l.MoveToBack(h); err != nil {
+		t.Errorf("MoveToBack() error = %v", err)
+	}
+	if next, ok := h.Next(); ok {
+		t.Errorf("Next() of the tail = %v, true, want false", next.Value())
+	}
+	if v, err := l.Remove(h); v != 30 || err != nil {
+		t.Errorf("Remove() = %v, %v, want 30, nil", v, err)
+	}
+	if _, err := l.Remove(h); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("second Remove() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	checkReadOnlyList(t, snap2, []int{30, 0, 1, 2})
+	checkReadOnlyList(t, snap, []int{1, 2, 3})
+	checkEnds(t, l, []int{0, 1, 2})
+}
+
+func TestDoublyLinkedList_SnapshotNoOpDoesNotCopy(t *testing.T) {
+	other := NewLinkedListFrom(DoublyLinked, 3).(*DoublyLinkedList[int])
+	foreign, _ := other.FirstHandle()
+	tests := []struct {
+		name   string
+		modify func(l *DoublyLinkedList[int])
+	}{
+		{"Update of an invalid handle", func(l *DoublyLinkedList[int]) {
+			if err := l.Update(foreign, 0); !errors.Is(err, ErrInvalidHandle) {
+				t.Errorf("Update() error = %v, want %v", err, ErrInvalidHandle)
+			}
+		}},
+		{"RemoveIf without match", func(l *DoublyLinkedList[int]) {
+			if n := RemoveIf[int](l, func(e int) bool { return e > 5 }); n != 0 {
+				t.Errorf("RemoveIf() = %v, want 0", n)
+			}
+		}},
+		{"RemoveFirstOccurrence without match", func(l *DoublyLinkedList[int]) {
+			if ok := RemoveFirstOccurrence[int](l, 5); ok {
+				t.Errorf("RemoveFirstOccurrence() = %v, want false", ok)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLinkedListFrom(DoublyLinked, 1, 2).(*DoublyLinkedList[int])
+			l.Snapshot()
+			head := l.head
+			it := l.ListIterator()
+			tt.modify(l)
+			if l.head != head || !l.shared {
+				t.Errorf("a call modifying nothing copied the shared nodes")
+			}
+			if _, err := it.Next(); err != nil {
+				t.Errorf("Next() error = %v, want nil", err)
+			}
+		})
+	}
+
+	// the copy made by a match keeps the removal going over the rest of the list
+	l := NewLinkedListFrom(DoublyLinked, 1, 2, 3, 4).(*DoublyLinkedList[int])
+	snap := l.Snapshot()
+	RemoveIf[int](l, func(e int) bool { return e%2 == 0 })
+	checkEnds(t, l, []int{1, 3})
+	checkReadOnlyList(t, snap, []int{1, 2, 3, 4})
+}
+
+// TestDoublyLinkedList_SnapshotLRU uses the list as the recency order of a cache, which keeps handles
+// across snapshots: the handles must keep working after the list copies its nodes.
+func TestDoublyLinkedList_SnapshotLRU(t *testing.T) {
+	l := &DoublyLinkedList[string]{}
+	handles := map[string]Handle[string]{}
+	for _, k := range []string{"a", "b", "c"} {
+		handles[k] = l.AddFirstHandle(k)
+	}
+	for i, k := range []string{"a", "b", "a", "c"} {
+		snap := l.Snapshot()
+		want := snap.ToSlice()
+		if err := l.MoveToFront(handles[k]); err != nil {
+			t.Fatalf("access %d: MoveToFront(%v) error = %v", i, k, err)
+		}
+		checkReadOnlyList(t, snap, want)
+	}
+	checkEnds(t, l, []string{"c", "a", "b"})
+}
+
+func TestDoublyLinkedList_SnapshotAppendDoesNotCopy(t *testing.T) {
+	l := NewLinkedListFrom(DoublyLinked, 2, 3).(*DoublyLinkedList[int])
+	snap := l.Snapshot()
+	head, tail := l.head, l.tail
+	it := l.ListIterator()
+	l.AddLast(4)
+	l.AddFirst(1)
+	l.AddAll(slices.Values([]int{5, 6}))
+	l.AddLastHandle(7)
+	l.AddFirstHandle(0)
+	if l.head.next.next != head || l.tail.prev.prev.prev.prev != tail || !l.shared {
+		t.Errorf("adding at the ends copied the shared nodes")
+	}
+	snap2 := l.Snapshot()
+	l.OfferLast(8)
+	checkEnds(t, l, []int{0, 1, 2, 3, 4, 5, 6, 7, 8})
+	checkReadOnlyList(t, snap, []int{2, 3})
+	checkReadOnlyList(t, snap2, []int{0, 1, 2, 3, 4, 5, 6, 7})
+
+	// the first other modification copies the nodes
+	l.RemoveAt(4)
+	if l.head.next.next == head {
+		t.Errorf("RemoveAt() did not copy the shared nodes")
+	}
+	checkEnds(t, l, []int{0, 1, 2, 3, 5, 6, 7, 8})
+	checkReadOnlyList(t, snap, []int{2, 3})
+	checkReadOnlyList(t, snap2, []int{0, 1, 2, 3, 4, 5, 6, 7})
+	if _, err := it.Next(); !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("Next() error = %v, want %v", err, ErrConcurrentModification)
+	}
+}
+
+func TestDoublyLinkedList_SnapshotWithPool(t *testing.T) {
+	pool := NewFreeListPool[int](0)
+	l := NewDoublyLinkedListWithPool(pool)
+	l.AddAll(slices.Values([]int{1, 2, 3}))
+	snap := l.Snapshot()
+	l.RemoveFirst()
+	l.Clear()
+	// the nodes of the snapshot are not given back to the pool, so they are not reused by the list
+	l.AddAll(slices.Values([]int{7, 8, 9, 10}))
+	checkReadOnlyList(t, snap, []int{1, 2, 3})
+	checkEnds[int](t, l, []int{7, 8, 9, 10})
+
+	var empty DoublyLinkedList[int]
+	checkReadOnlyList(t, empty.Snapshot(), []int{})
+	if got := snap.String(); got != "1 <=> 2 <=> 3" {
+		t.Errorf("String() = %q, want %q", got, "1 <=> 2 <=> 3")
+	}
+}
+
+// TestDoublyLinkedList_SnapshotConcurrentReaders reads snapshots while the list keeps adding at both ends
+// without copying its nodes, run it with -race.
+func TestDoublyLinkedList_SnapshotConcurrentReaders(t *testing.T) {
+	l := &DoublyLinkedList[int]{}
+	var wg sync.WaitGroup
+	for i := range 50 {
+		l.AddLast(i)
+		l.AddFirst(-i)
+		snap := l.Snapshot()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sum, reverseSum := 0, 0
+			for _, v := range snap.All() {
+				sum += v
+			}
+			for _, v := range snap.ReverseAll() {
+				reverseSum += v
+			}
+			if first, _ := snap.GetFirst(); sum != 0 || reverseSum != 0 || first != -i {
+				t.Errorf("snapshot %v: sums = %v, %v, GetFirst() = %v, want 0, 0, %v", i, sum, reverseSum, first, -i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkDoublyLinkedList_SnapshotAppend measures a Snapshot followed by an AddLast on a 1M element list,
+// as a writer appending while reporting goroutines take snapshots. The append does not copy the nodes.
+func BenchmarkDoublyLinkedList_SnapshotAppend(b *testing.B) {
+	l := &DoublyLinkedList[int]{}
+	for i := range benchmarkListLen {
+		l.AddLast(i)
+	}
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		l.Snapshot()
+		l.AddLast(i)
+	}
+}
+
+// BenchmarkDoublyLinkedList_SnapshotRemoveFirst measures a Snapshot followed by a RemoveFirst on a 1M element list:
+// the RemoveFirst copies the whole list, which is the cost of a modification other than an append after a Snapshot.
+func BenchmarkDoublyLinkedList_SnapshotRemoveFirst(b *testing.B) {
+	l := &DoublyLinkedList[int]{}
+	for i := range benchmarkListLen {
+		l.AddLast(i)
+	}
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		l.Snapshot()
+		l.RemoveFirst()
+		l.AddLast(i)
+	}
+}
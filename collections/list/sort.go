@@ -0,0 +1,145 @@
+package list
+
+// Sort sorts the list in ascending order as determined by the cmp function.
+// cmp(a, b) should return a negative number when a < b, a positive number when a > b and zero when a == b
+// (as [cmp.Compare] does for ordered types).
+//
+// The nodes of the list are relinked in place, so sorting takes O(n log n) time and allocates no memory.
+// The sort is stable, so Sort is equivalent to [SinglyLinkedList.SortStable].
+func (s *SinglyLinkedList[T]) Sort(cmp func(a, b T) int) {
+	s.SortStable(cmp)
+}
+
+// SortStable sorts the list in ascending order as determined by the cmp function,
+// keeping the original order of equal elements.
+// The nodes of the list are relinked in place, so sorting takes O(n log n) time and allocates no memory.
+func (s *SinglyLinkedList[T]) SortStable(cmp func(a, b T) int) {
+	if s.Len() < 2 {
+		return
+	}
+	s.head, s.tail = mergeSortSinglyLinked(s.head, cmp)
+	s.modCount++
+}
+
+// Sort sorts the list in ascending order as determined by the cmp function.
+// cmp(a, b) should return a negative number when a < b, a positive number when a > b and zero when a == b
+// (as [cmp.Compare] does for ordered types).
+//
+// The nodes of the list are relinked in place, so sorting takes O(n log n) time and allocates no memory.
+// The sort is stable, so Sort is equivalent to [DoublyLinkedList.SortStable].
+func (d *DoublyLinkedList[T]) Sort(cmp func(a, b T) int) {
+	d.SortStable(cmp)
+}
+
+// SortStable sorts the list in ascending order as determined by the cmp function,
+// keeping the original order of equal elements.
+// The nodes of the list are relinked in place, so sorting takes O(n log n) time and allocates no memory.
+func (d *DoublyLinkedList[T]) SortStable(cmp func(a, b T) int) {
+	if d.Len() < 2 {
+		return
+	}
+	d.unshare()
+	d.head, d.tail = mergeSortDoublyLinked(d.head, cmp)
+	d.modCount++
+}
+
+// Sort sorts the list in ascending order as determined by the cmp function, starting from the head.
+// cmp(a, b) should return a negative number when a < b, a positive number when a > b and zero when a == b
+// (as [cmp.Compare] does for ordered types).
+//
+// The nodes of the list are relinked in place, so sorting takes O(n log n) time and allocates no memory.
+// The sort is stable, so Sort is equivalent to [CircularLinkedList.SortStable].
+func (c *CircularLinkedList[T]) Sort(cmp func(a, b T) int) {
+	c.SortStable(cmp)
+}
+
+// SortStable sorts the list in ascending order as determined by the cmp function, starting from the head,
+// keeping the original order of equal elements.
+// The nodes of the list are relinked in place, so sorting takes O(n log n) time and allocates no memory.
+func (c *CircularLinkedList[T]) SortStable(cmp func(a, b T) int) {
+	if c.Len() < 2 {
+		return
+	}
+	// open the circle, sort it as a doubly linked list and close it again.
+	c.tail.next, c.head.prev = nil, nil
+	c.head, c.tail = mergeSortDoublyLinked(c.head, cmp)
+	c.tail.next, c.head.prev = c.head, c.tail
+	c.modCount++
+}
+
+// mergeSortSinglyLinked sorts the nil terminated chain of nodes starting at head
+// using a bottom-up merge sort, and returns the new head and tail of the chain.
+func mergeSortSinglyLinked[T any](head *singlyLinkedNode[T], cmp func(a, b T) int) (*singlyLinkedNode[T], *singlyLinkedNode[T]) {
+	var tail *singlyLinkedNode[T]
+	for width := 1; ; width *= 2 {
+		p, merges := head, 0
+		head, tail = nil, nil
+		for p != nil {
+			merges++
+			// q is the start of the second run, pLen and qLen are the lengths of the runs left to merge.
+			q, pLen, qLen := p, 0, width
+			for ; pLen < width && q != nil; pLen++ {
+				q = q.next
+			}
+			for pLen > 0 || (qLen > 0 && q != nil) {
+				var e *singlyLinkedNode[T]
+				// taking from the first run on ties keeps the sort stable.
+				if pLen > 0 && (qLen == 0 || q == nil || cmp(p.value, q.value) <= 0) {
+					e, p, pLen = p, p.next, pLen-1
+				} else {
+					e, q, qLen = q, q.next, qLen-1
+				}
+				if tail == nil {
+					head = e
+				} else {
+					tail.next = e
+				}
+				tail = e
+			}
+			p = q
+		}
+		tail.next = nil
+		if merges <= 1 {
+			return head, tail
+		}
+	}
+}
+
+// mergeSortDoublyLinked sorts the nil terminated chain of nodes starting at head
+// using a bottom-up merge sort, and returns the new head and tail of the chain.
+// The prev pointers are relinked along with the next pointers.
+func mergeSortDoublyLinked[T any](head *doublyLinkedNode[T], cmp func(a, b T) int) (*doublyLinkedNode[T], *doublyLinkedNode[T]) {
+	var tail *doublyLinkedNode[T]
+	for width := 1; ; width *= 2 {
+		p, merges := head, 0
+		head, tail = nil, nil
+		for p != nil {
+			merges++
+			// q is the start of the second run, pLen and qLen are the lengths of the runs left to merge.
+			q, pLen, qLen := p, 0, width
+			for ; pLen < width && q != nil; pLen++ {
+				q = q.next
+			}
+			for pLen > 0 || (qLen > 0 && q != nil) {
+				var e *doublyLinkedNode[T]
+				// taking from the first run on ties keeps the sort stable.
+				if pLen > 0 && (qLen == 0 || q == nil || cmp(p.value, q.value) <= 0) {
+					e, p, pLen = p, p.next, pLen-1
+				} else {
+					e, q, qLen = q, q.next, qLen-1
+				}
+				if tail == nil {
+					head = e
+				} else {
+					tail.next = e
+				}
+				e.prev, tail = tail, e
+			}
+			p = q
+		}
+		tail.next = nil
+		if merges <= 1 {
+			return head, tail
+		}
+	}
+}
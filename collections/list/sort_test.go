@@ -0,0 +1,93 @@
+package list
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// sortOf returns the SortStable method of the given LinkedList implementation.
+func sortOf[T any](l LinkedList[T]) func(cmp func(a, b T) int) {
+	return l.(interface{ SortStable(cmp func(a, b T) int) }).SortStable
+}
+
+// sortedCopy returns a sorted copy of s. Unlike slices.Sorted, it returns an empty slice rather than nil
+// for an empty s, as ToSlice does.
+func sortedCopy[T cmp.Ordered](s []T) []T {
+	sorted := slices.Clone(s)
+	slices.Sort(sorted)
+	return sorted
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{"empty", []int{}},
+		{"one element", []int{5}},
+		{"two elements", []int{5, 1}},
+		{"sorted", []int{1, 2, 3, 4, 5, 6, 7}},
+		{"reversed", []int{9, 8, 7, 6, 5, 4, 3, 2, 1}},
+		{"duplicates", []int{3, 1, 3, 2, 1, 3, 2}},
+		{"random", rand.Perm(1000)},
+	}
+	for _, lt := range allLinkedListTypes {
+		for _, tt := range tests {
+			t.Run(lt.String()+"/"+tt.name, func(t *testing.T) {
+				list := NewLinkedListFromSlice(lt, tt.input)
+				sortOf(list)(cmp.Compare[int])
+				want := sortedCopy(tt.input)
+				checkEnds(t, list, want)
+				list.AddLast(1001).AddFirst(-1)
+				checkEnds(t, list, append(append([]int{-1}, want...), 1001))
+			})
+		}
+	}
+}
+
+func TestLinkedList_SortStable(t *testing.T) {
+	type pair struct {
+		key, seq int
+	}
+	byKey := func(a, b pair) int {
+		return cmp.Compare(a.key, b.key)
+	}
+	input := make([]pair, 500)
+	for i := range input {
+		input[i] = pair{rand.IntN(10), i}
+	}
+	want := slices.Clone(input)
+	slices.SortStableFunc(want, byKey)
+
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := NewLinkedListFromSlice(lt, input)
+			sortOf(list)(byKey)
+			checkEnds(t, list, want)
+		})
+	}
+}
+
+func TestCircularLinkedList_SortKeepsCircle(t *testing.T) {
+	list := &CircularLinkedList[int]{}
+	list.AddLast(3).AddLast(1).AddLast(2)
+	list.Sort(cmp.Compare[int])
+	if list.head.prev != list.tail || list.tail.next != list.head {
+		t.Errorf("head.prev = %v, tail.next = %v, want tail and head", list.head.prev.value, list.tail.next.value)
+	}
+}
+
+func TestLinkedList_SortFailsIterators(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := NewLinkedListFrom(lt, 2, 1, 3)
+			expectConcurrentModification(t, func() {
+				for range list.All() {
+					sortOf(list)(cmp.Compare[int])
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,423 @@
+package list
+
+import (
+	"iter"
+)
+
+// ListView is a live view of the elements of a list between two indexes, returned by the SubList methods
+// (ex: [DoublyLinkedList.SubList]). It implements [LinkedList] with indexes relative to the start of the view.
+//
+// The view holds no elements of its own: reads go to the nodes of the parent list, and modifications made
+// through the view are applied to the parent list, moving the end of the view accordingly.
+// Ex:
+//
+//	l := NewLinkedListFrom(DoublyLinked, 1, 2, 3, 4, 5).(*DoublyLinkedList[int])
+//	view, _ := l.SubList(1, 4) // 2, 3, 4
+//	view.RemoveFirst()         // l: 1, 3, 4, 5
+//	view.AddLast(9)            // l: 1, 3, 4, 9, 5
+//
+// Like the iterators, the view is fail-fast: once the parent list is structurally modified other than through
+// the view, the methods of the view returning an error return an [ErrConcurrentModification] error,
+// and the other methods panic with it.
+//
+// The nodes returned by [ListView.GetHeadNode] and [ListView.GetTailNode] are the nodes of the parent list,
+// so following their links can lead outside the view.
+type ListView[T any] struct {
+	parent           viewParent[T]
+	offset, len      int
+	expectedModCount int // modification count of the parent when the view was last in sync with it
+}
+
+// viewParent is the list a [ListView] is backed by: one of the list types, or another view.
+type viewParent[T any] interface {
+	LinkedList[T]
+	RemoveRange(from, to int) error
+	modifications() int
+}
+
+// SubList returns a live view of the elements of the list from the index from (inclusive) to the index to (exclusive).
+// See [ListView]. An [ErrIndexOutOfBounds] error is returned if from is less than 0, to is greater than the length
+// of the list, or from is greater than to.
+func (s *SinglyLinkedList[T]) SubList(from, to int) (*ListView[T], error) {
+	return newListView[T](s, from, to)
+}
+
+// SubList returns a live view of the elements of the list from the index from (inclusive) to the index to (exclusive).
+// See [ListView]. An [ErrIndexOutOfBounds] error is returned if from is less than 0, to is greater than the length
+// of the list, or from is greater than to.
+func (d *DoublyLinkedList[T]) SubList(from, to int) (*ListView[T], error) {
+	return newListView[T](d, from, to)
+}
+
+// SubList returns a live view of the elements of the list from the index from (inclusive) to the index to (exclusive),
+// starting from the head. See [ListView]. An [ErrIndexOutOfBounds] error is returned if from is less than 0,
+// to is greater than the length of the list, or from is greater than to.
+func (c *CircularLinkedList[T]) SubList(from, to int) (*ListView[T], error) {
+	return newListView[T](c, from, to)
+}
+
+// SubList returns a live view of the elements of this view from the index from (inclusive) to the index to (exclusive).
+// Modifications made through the returned view are applied to this view, and so to its parent list.
+func (v *ListView[T]) SubList(from, to int) (*ListView[T], error) {
+	if err := v.sync(); err != nil {
+		return nil, err
+	}
+	return newListView[T](v, from, to)
+}
+
+func newListView[T any](parent viewParent[T], from, to int) (*ListView[T], error) {
+	if err := checkRange(from, to, parent.Len()); err != nil {
+		return nil, err
+	}
+	return &ListView[T]{parent: parent, offset: from, len: to - from, expectedModCount: parent.modifications()}, nil
+}
+
+// RemoveRange removes the elements from the index from (inclusive) to the index to (exclusive) in a single pass.
+// An [ErrIndexOutOfBounds] error is returned if from is less than 0, to is greater than the length of the list,
+// or from is greater than to; in that case the list is not modified.
+func (s *SinglyLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange(from, to, s.Len()); err != nil {
+		return err
+	}
+	pred := s.nodeAt(from - 1)
+	for range to - from {
+		if pred == nil {
+			s.unlinkAfter(nil, s.head)
+		} else {
+			s.unlinkAfter(pred, pred.next)
+		}
+	}
+	return nil
+}
+
+// RemoveRange removes the elements from the index from (inclusive) to the index to (exclusive) in a single pass.
+// An [ErrIndexOutOfBounds] error is returned if from is less than 0, to is greater than the length of the list,
+// or from is greater than to; in that case the list is not modified.
+func (d *DoublyLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange(from, to, d.Len()); err != nil {
+		return err
+	}
+	d.unshare()
+	cur := d.nodeAt(from)
+	for range to - from {
+		next := cur.next
+		d.remove(cur)
+		cur = next
+	}
+	return nil
+}
+
+// RemoveRange removes the elements from the index from (inclusive) to the index to (exclusive),
+// starting from the head, in a single pass.
+// An [ErrIndexOutOfBounds] error is returned if from is less than 0, to is greater than the length of the list,
+// or from is greater than to; in that case the list is not modified.
+func (c *CircularLinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange(from, to, c.Len()); err != nil {
+		return err
+	}
+	cur := c.nodeAt(from)
+	for range to - from {
+		// next is taken before unlinking, as unlink clears the links of the node
+		next := cur.next
+		c.remove(cur)
+		cur = next
+	}
+	return nil
+}
+
+// RemoveRange removes the elements of this view from the index from (inclusive) to the index to (exclusive)
+// from the parent list.
+func (v *ListView[T]) RemoveRange(from, to int) error {
+	if err := v.sync(); err != nil {
+		return err
+	}
+	if err := checkRange(from, to, v.len); err != nil {
+		return err
+	}
+	if err := v.parent.RemoveRange(v.offset+from, v.offset+to); err != nil {
+		return err
+	}
+	v.len -= to - from
+	v.expectedModCount = v.parent.modifications()
+	return nil
+}
+
+// checkRange returns an [ErrIndexOutOfBounds] error for the first index of the range [from, to)
+// that is not valid in a list of the given length.
+func checkRange(from, to, len int) error {
+	switch {
+	case from < 0 || from > len:
+		return errIndexOutOfBounds(from, len)
+	case to < from || to > len:
+		return errIndexOutOfBounds(to, len)
+	}
+	return nil
+}
+
+// ListView methods.
+
+// AddLast appends e to the end of the view, right before the element following the view in the parent list.
+func (v *ListView[T]) AddLast(e T) LinkedList[T] {
+	v.mustSync()
+	v.insert(e, v.len)
+	return v
+}
+
+// AddFirst adds e to the beginning of the view, right after the element preceding the view in the parent list.
+func (v *ListView[T]) AddFirst(e T) LinkedList[T] {
+	v.mustSync()
+	v.insert(e, 0)
+	return v
+}
+
+func (v *ListView[T]) Insert(e T, index int) (bool, error) {
+	if err := v.sync(); err != nil {
+		return false, err
+	}
+	if index < 0 || index > v.len {
+		return false, errIndexOutOfBounds(index, v.len)
+	}
+	v.insert(e, index)
+	return true, nil
+}
+
+func (v *ListView[T]) GetFirst() (T, error) {
+	if err := v.sync(); err != nil || v.len == 0 {
+		return v.noElement(err)
+	}
+	return v.parent.Get(v.offset)
+}
+
+func (v *ListView[T]) GetLast() (T, error) {
+	if err := v.sync(); err != nil || v.len == 0 {
+		return v.noElement(err)
+	}
+	return v.parent.Get(v.offset + v.len - 1)
+}
+
+func (v *ListView[T]) Get(index int) (T, error) {
+	if err := v.sync(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if index < 0 || index >= v.len {
+		var zero T
+		return zero, errIndexOutOfBounds(index, v.len)
+	}
+	return v.parent.Get(v.offset + index)
+}
+
+func (v *ListView[T]) GetHeadNode() (ImmutableNode[T], error) {
+	if err := v.sync(); err != nil {
+		return nil, err
+	}
+	if v.len == 0 {
+		return nil, errNoSuchElement()
+	}
+	return v.nodeAt(0), nil
+}
+
+func (v *ListView[T]) GetTailNode() (ImmutableNode[T], error) {
+	if err := v.sync(); err != nil {
+		return nil, err
+	}
+	if v.len == 0 {
+		return nil, errNoSuchElement()
+	}
+	return v.nodeAt(v.len - 1), nil
+}
+
+func (v *ListView[T]) Len() int {
+	v.mustSync()
+	return v.len
+}
+
+func (v *ListView[T]) IsEmpty() bool {
+	return v.Len() == 0
+}
+
+func (v *ListView[T]) RemoveFirst() (T, error) {
+	if err := v.sync(); err != nil || v.len == 0 {
+		return v.noElement(err)
+	}
+	return v.removeAt(0)
+}
+
+func (v *ListView[T]) RemoveLast() (T, error) {
+	if err := v.sync(); err != nil || v.len == 0 {
+		return v.noElement(err)
+	}
+	return v.removeAt(v.len - 1)
+}
+
+func (v *ListView[T]) RemoveAt(index int) (T, error) {
+	if err := v.sync(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if index < 0 || index >= v.len {
+		var zero T
+		return zero, errIndexOutOfBounds(index, v.len)
+	}
+	return v.removeAt(index)
+}
+
+func (v *ListView[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		v.mustSync()
+		expectedModCount := v.expectedModCount
+		var cur ImmutableNode[T]
+		for i := 0; i < v.len; i++ {
+			if i == 0 {
+				cur = v.nodeAt(0)
+			} else {
+				cur = cur.Next()
+			}
+			if !yield(i, cur.Value()) {
+				return
+			}
+			v.checkModCount(expectedModCount)
+		}
+	}
+}
+
+func (v *ListView[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range v.All() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (v *ListView[T]) ReverseAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		v.mustSync()
+		if v.len == 0 {
+			return
+		}
+		expectedModCount := v.expectedModCount
+		tail := v.nodeAt(v.len - 1)
+		if v.len > 1 && tail.Prev() == nil {
+			// singly linked nodes can only be walked forward
+			values := v.ToSlice()
+			for i := len(values) - 1; i >= 0; i-- {
+				if !yield(i, values[i]) {
+					return
+				}
+				v.checkModCount(expectedModCount)
+			}
+			return
+		}
+		for i, cur := v.len-1, tail; i >= 0; i-- {
+			if !yield(i, cur.Value()) {
+				return
+			}
+			v.checkModCount(expectedModCount)
+			if i > 0 {
+				cur = cur.Prev()
+			}
+		}
+	}
+}
+
+func (v *ListView[T]) ToSlice() []T {
+	if v == nil {
+		return nil
+	}
+	slice := make([]T, 0, v.Len())
+	for e := range v.Values() {
+		slice = append(slice, e)
+	}
+	return slice
+}
+
+// String formats the elements of the view as a list of the same type as its parent would, ex: 1 <=> 2
+// for a view of a [DoublyLinkedList].
+func (v *ListView[T]) String() string {
+	if v == nil {
+		return "nil"
+	}
+	return NewLinkedListFromSlice(v.listType(), v.ToSlice()).String()
+}
+
+func (v *ListView[T]) modifications() int {
+	return v.parent.modifications()
+}
+
+// listType returns the type of the list the view is backed by, so that Map or Filter of a view
+// return a list of the same type as its parent.
+func (v *ListView[T]) listType() linkedListType {
+	return typeOf[T](v.parent)
+}
+
+// sync returns an [ErrConcurrentModification] error if the parent list was structurally modified
+// other than through this view.
+func (v *ListView[T]) sync() error {
+	if v.parent.modifications() != v.expectedModCount {
+		return errConcurrentModification()
+	}
+	return nil
+}
+
+// mustSync is like sync, but panics with the error, for the methods that cannot return an error.
+func (v *ListView[T]) mustSync() {
+	if err := v.sync(); err != nil {
+		panic(err)
+	}
+}
+
+// checkModCount panics with an [ErrConcurrentModification] error
+// if the parent list was structurally modified since expectedModCount was read.
+func (v *ListView[T]) checkModCount(expectedModCount int) {
+	if v.parent.modifications() != expectedModCount {
+		panic(errConcurrentModification())
+	}
+}
+
+// insert inserts e at the valid index of the view.
+func (v *ListView[T]) insert(e T, index int) {
+	v.parent.Insert(e, v.offset+index)
+	v.len++
+	v.expectedModCount = v.parent.modifications()
+}
+
+// removeAt removes the element at the valid index of the view.
+func (v *ListView[T]) removeAt(index int) (T, error) {
+	e, err := v.parent.RemoveAt(v.offset + index)
+	if err == nil {
+		v.len--
+		v.expectedModCount = v.parent.modifications()
+	}
+	return e, err
+}
+
+// noElement returns err, or an [ErrNoSuchElement] error if err is nil.
+func (v *ListView[T]) noElement(err error) (T, error) {
+	var zero T
+	if err == nil {
+		err = errNoSuchElement()
+	}
+	return zero, err
+}
+
+// nodeAt returns the node of the parent list at the valid index of the view,
+// walking from the nearer end of the parent list when its nodes can be walked backward.
+func (v *ListView[T]) nodeAt(index int) ImmutableNode[T] {
+	i, n := v.offset+index, v.parent.Len()
+	if i >= n/2 {
+		tail, _ := v.parent.GetTailNode()
+		if n == 1 || tail.Prev() != nil {
+			for j := n - 1; j > i; j-- {
+				tail = tail.Prev()
+			}
+			return tail
+		}
+	}
+	head, _ := v.parent.GetHeadNode()
+	for j := 0; j < i; j++ {
+		head = head.Next()
+	}
+	return head
+}
@@ -0,0 +1,144 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// rangeList is the SubList and RemoveRange API shared by the list types.
+type rangeList[T any] interface {
+	LinkedList[T]
+	SubList(from, to int) (*ListView[T], error)
+	RemoveRange(from, to int) error
+}
+
+func TestLinkedList_RemoveRange(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4, 5}
+	for _, lt := range allLinkedListTypes {
+		for from := 0; from <= len(values); from++ {
+			for to := from; to <= len(values); to++ {
+				t.Run(fmt.Sprintf("%v/%v-%v", lt, from, to), func(t *testing.T) {
+					l := NewLinkedListFromSlice(lt, values).(rangeList[int])
+					if err := l.RemoveRange(from, to); err != nil {
+						t.Fatalf("RemoveRange(%v, %v) error = %v", from, to, err)
+					}
+					want := slices.Delete(slices.Clone(values), from, to)
+					checkEnds(t, l, want)
+					l.AddLast(9).AddFirst(8)
+					checkEnds(t, l, append(append([]int{8}, want...), 9))
+				})
+			}
+		}
+		t.Run(lt.String()+"/out of bounds", func(t *testing.T) {
+			l := NewLinkedListFromSlice(lt, values).(rangeList[int])
+			for _, r := range [][2]int{{-1, 2}, {2, 7}, {4, 3}, {7, 7}} {
+				if err := l.RemoveRange(r[0], r[1]); !errors.Is(err, ErrIndexOutOfBounds) {
+					t.Errorf("RemoveRange(%v, %v) error = %v, want %v", r[0], r[1], err, ErrIndexOutOfBounds)
+				}
+				if _, err := l.SubList(r[0], r[1]); !errors.Is(err, ErrIndexOutOfBounds) {
+					t.Errorf("SubList(%v, %v) error = %v, want %v", r[0], r[1], err, ErrIndexOutOfBounds)
+				}
+			}
+			checkEnds(t, l, values)
+		})
+	}
+}
+
+func TestLinkedList_SubList(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 1, 2, 3, 4, 5).(rangeList[int])
+			view, err := l.SubList(1, 4)
+			if err != nil {
+				t.Fatalf("SubList(1, 4) error = %v", err)
+			}
+			checkEnds[int](t, view, []int{2, 3, 4})
+
+			view.RemoveFirst()
+			view.AddLast(9)
+			view.Insert(7, 1)
+			checkEnds[int](t, view, []int{3, 7, 4, 9})
+			checkEnds(t, l, []int{1, 3, 7, 4, 9, 5})
+			if _, err := view.Get(4); !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Errorf("Get(4) error = %v, want %v", err, ErrIndexOutOfBounds)
+			}
+
+			inner, _ := view.SubList(1, 3)
+			checkEnds[int](t, inner, []int{7, 4})
+			inner.RemoveLast()
+			inner.AddFirst(6)
+			checkEnds[int](t, inner, []int{6, 7})
+			checkEnds[int](t, view, []int{3, 6, 7, 9})
+			checkEnds(t, l, []int{1, 3, 6, 7, 9, 5})
+
+			if err := view.RemoveRange(0, 2); err != nil {
+				t.Fatalf("RemoveRange(0, 2) error = %v", err)
+			}
+			checkEnds[int](t, view, []int{7, 9})
+			checkEnds(t, l, []int{1, 7, 9, 5})
+			if s, want := view.String(), NewLinkedListFrom(lt, 7, 9).String(); s != want {
+				t.Errorf("String() = %v, want %v", s, want)
+			}
+			var nilView *ListView[int]
+			if s := nilView.String(); s != "nil" {
+				t.Errorf("String() of a nil view = %v, want nil", s)
+			}
+		})
+	}
+}
+
+func TestLinkedList_SubListConcurrentModification(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			l := NewLinkedListFrom(lt, 1, 2, 3, 4).(rangeList[int])
+			view, _ := l.SubList(1, 3)
+			sibling, _ := l.SubList(0, 2)
+			// modifying through a view invalidates the other views only
+			view.AddFirst(0)
+			if _, err := view.Get(0); err != nil {
+				t.Errorf("Get(0) error = %v", err)
+			}
+			if _, err := sibling.Get(0); !errors.Is(err, ErrConcurrentModification) {
+				t.Errorf("sibling Get(0) error = %v, want %v", err, ErrConcurrentModification)
+			}
+
+			l.AddLast(5)
+			for name, err := range map[string]error{
+				"Get":      func() error { _, err := view.Get(0); return err }(),
+				"GetFirst": func() error { _, err := view.GetFirst(); return err }(),
+				"Insert":   func() error { _, err := view.Insert(0, 0); return err }(),
+				"RemoveAt": func() error { _, err := view.RemoveAt(0); return err }(),
+				"SubList":  func() error { _, err := view.SubList(0, 0); return err }(),
+			} {
+				if !errors.Is(err, ErrConcurrentModification) {
+					t.Errorf("%v() error = %v, want %v", name, err, ErrConcurrentModification)
+				}
+			}
+			expectConcurrentModification(t, func() { view.Len() })
+			expectConcurrentModification(t, func() { view.AddLast(1) })
+			expectConcurrentModification(t, func() {
+				for range view.All() {
+				}
+			})
+			checkEnds(t, l, []int{1, 0, 2, 3, 4, 5})
+		})
+	}
+}
+
+func TestListView_IterationFailFast(t *testing.T) {
+	l := NewLinkedListFrom(DoublyLinked, 1, 2, 3, 4).(*DoublyLinkedList[int])
+	view, _ := l.SubList(1, 3)
+	expectConcurrentModification(t, func() {
+		for range view.Values() {
+			view.AddLast(0)
+		}
+	})
+	view, _ = l.SubList(1, 3)
+	expectConcurrentModification(t, func() {
+		for range view.ReverseAll() {
+			l.RemoveFirst()
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package list
+
+import (
+	"iter"
+	"sync"
+)
+
+// SynchronizedList is a [LinkedList] that can be shared between goroutines.
+// It wraps another LinkedList and guards every method with a [sync.RWMutex]:
+// methods that read the list hold the read lock, methods that modify it hold the write lock.
+//
+// Iterators ([SynchronizedList.All], [SynchronizedList.Values] and [SynchronizedList.ReverseAll])
+// copy the elements under the read lock when the iteration starts and then iterate over that snapshot
+// without holding any lock. So the loop body may freely modify the list, and it observes a consistent view
+// of the list as it was when the iteration started, never a partial modification of another goroutine.
+//
+// The nodes returned by [SynchronizedList.GetHeadNode] and [SynchronizedList.GetTailNode] are the live nodes
+// of the wrapped list; following their links is not synchronized.
+//
+// The wrapped list must not be used directly once it is wrapped.
+type SynchronizedList[T any] struct {
+	mu   sync.RWMutex
+	list LinkedList[T]
+}
+
+// Synchronized returns a [SynchronizedList] that guards the given list with a read-write mutex.
+// If list is nil, an empty [DoublyLinkedList] is wrapped.
+// Ex:
+//
+//	queue := Synchronized(NewLinkedList[int](DoublyLinked))
+//	go func() { queue.AddLast(1) }()
+//	go func() { queue.RemoveFirst() }()
+func Synchronized[T any](list LinkedList[T]) *SynchronizedList[T] {
+	if list == nil {
+		list = NewLinkedList[T](DoublyLinked)
+	}
+	return &SynchronizedList[T]{list: list}
+}
+
+// Do calls f with the wrapped list while holding the write lock,
+// so a sequence of operations (ex: check then remove) is applied atomically.
+// f must not retain the list or call methods of the SynchronizedList, which would deadlock.
+func (s *SynchronizedList[T]) Do(f func(list LinkedList[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.list)
+}
+
+func (s *SynchronizedList[T]) AddLast(e T) LinkedList[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.AddLast(e)
+	return s
+}
+
+func (s *SynchronizedList[T]) AddFirst(e T) LinkedList[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.AddFirst(e)
+	return s
+}
+
+func (s *SynchronizedList[T]) Insert(e T, index int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Insert(e, index)
+}
+
+func (s *SynchronizedList[T]) GetFirst() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetFirst()
+}
+
+func (s *SynchronizedList[T]) GetLast() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetLast()
+}
+
+func (s *SynchronizedList[T]) Get(index int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Get(index)
+}
+
+func (s *SynchronizedList[T]) GetHeadNode() (ImmutableNode[T], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetHeadNode()
+}
+
+func (s *SynchronizedList[T]) GetTailNode() (ImmutableNode[T], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetTailNode()
+}
+
+func (s *SynchronizedList[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Len()
+}
+
+func (s *SynchronizedList[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.IsEmpty()
+}
+
+func (s *SynchronizedList[T]) RemoveFirst() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveFirst()
+}
+
+func (s *SynchronizedList[T]) RemoveLast() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveLast()
+}
+
+func (s *SynchronizedList[T]) RemoveAt(index int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveAt(index)
+}
+
+// All returns an iterator over a snapshot of the list taken when the iteration starts.
+func (s *SynchronizedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s.ToSlice() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over a snapshot of the values of the list taken when the iteration starts.
+func (s *SynchronizedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ReverseAll returns a reverse order iterator over a snapshot of the list taken when the iteration starts.
+func (s *SynchronizedList[T]) ReverseAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		snapshot := s.ToSlice()
+		for i := len(snapshot) - 1; i >= 0; i-- {
+			if !yield(i, snapshot[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SynchronizedList[T]) ToSlice() []T {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.ToSlice()
+}
+
+func (s *SynchronizedList[T]) String() string {
+	if s == nil {
+		return "nil"
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.String()
+}
+
+// listType returns the type of the wrapped list, which never changes, so it takes no lock.
+func (s *SynchronizedList[T]) listType() linkedListType {
+	return typeOf(s.list)
+}
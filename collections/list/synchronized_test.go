@@ -0,0 +1,201 @@
+package list
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSynchronized_LinkedList(t *testing.T) {
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := Synchronized(NewLinkedList[int](lt))
+			list.AddLast(2).AddFirst(1).AddLast(4)
+			if ok, err := list.Insert(3, 2); !ok || err != nil {
+				t.Errorf("Insert() = %t, %v, want %t, nil", ok, err, true)
+			}
+			checkEnds[int](t, list, []int{1, 2, 3, 4})
+			if v, _ := list.Get(2); v != 3 {
+				t.Errorf("Get() = %v, want %v", v, 3)
+			}
+			if v, _ := list.GetFirst(); v != 1 {
+				t.Errorf("GetFirst() = %v, want %v", v, 1)
+			}
+			if v, _ := list.GetLast(); v != 4 {
+				t.Errorf("GetLast() = %v, want %v", v, 4)
+			}
+			if list.String() != NewLinkedListFrom(lt, 1, 2, 3, 4).String() {
+				t.Errorf("String() = %v, want %v", list.String(), NewLinkedListFrom(lt, 1, 2, 3, 4).String())
+			}
+			if v, err := list.RemoveAt(1); v != 2 || err != nil {
+				t.Errorf("RemoveAt() = %v, %v, want %v, nil", v, err, 2)
+			}
+			list.RemoveFirst()
+			list.RemoveLast()
+			checkEnds[int](t, list, []int{3})
+			list.RemoveFirst()
+			if _, err := list.RemoveFirst(); !errors.Is(err, ErrNoSuchElement) {
+				t.Errorf("RemoveFirst() error = %v, want %v", err, ErrNoSuchElement)
+			}
+			if !list.IsEmpty() {
+				t.Errorf("IsEmpty() = %t, want %t", list.IsEmpty(), true)
+			}
+		})
+	}
+}
+
+func TestSynchronized_NilList(t *testing.T) {
+	list := Synchronized[int](nil)
+	list.AddLast(1)
+	if list.Len() != 1 {
+		t.Errorf("Len() = %d, want %d", list.Len(), 1)
+	}
+	var nilList *SynchronizedList[int]
+	if nilList.ToSlice() != nil || nilList.String() != "nil" {
+		t.Errorf("ToSlice(), String() = %v, %v, want nil, nil", nilList.ToSlice(), nilList.String())
+	}
+}
+
+func TestSynchronized_SnapshotIteration(t *testing.T) {
+	list := Synchronized(NewLinkedListFrom(DoublyLinked, 1, 2, 3))
+	var got []int
+	// modifying the list while iterating is allowed, the iteration sees the snapshot.
+	for _, v := range list.All() {
+		got = append(got, v)
+		list.AddLast(v * 10)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("All() = %v, want %v", got, []int{1, 2, 3})
+	}
+	got = got[:0]
+	for i, v := range list.ReverseAll() {
+		if i < 4 {
+			break
+		}
+		got = append(got, v)
+		list.RemoveFirst()
+	}
+	if !reflect.DeepEqual(got, []int{30, 20}) {
+		t.Errorf("ReverseAll() = %v, want %v", got, []int{30, 20})
+	}
+	checkEnds[int](t, list, []int{3, 10, 20, 30})
+}
+
+func TestSynchronized_Do(t *testing.T) {
+	list := Synchronized(NewLinkedListFrom(DoublyLinked, 1, 2, 3))
+	var wg sync.WaitGroup
+	removed := make([]int, 0, 3)
+	var mu sync.Mutex
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list.Do(func(l LinkedList[int]) {
+				if !l.IsEmpty() {
+					v, _ := l.RemoveFirst()
+					mu.Lock()
+					removed = append(removed, v)
+					mu.Unlock()
+				}
+			})
+		}()
+	}
+	wg.Wait()
+	if len(removed) != 3 || !list.IsEmpty() {
+		t.Errorf("removed %v, Len() = %d, want 3 elements and %d", removed, list.Len(), 0)
+	}
+}
+
+// TestSynchronized_Concurrent exercises the list from many goroutines, run it with -race.
+func TestSynchronized_Concurrent(t *testing.T) {
+	const producers, perProducer = 8, 500
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := Synchronized(NewLinkedList[int](lt))
+			var wg sync.WaitGroup
+			var removed sync.Map
+			removedCount := make(chan int, producers)
+			for p := range producers {
+				wg.Add(3)
+				go func() {
+					defer wg.Done()
+					for i := range perProducer {
+						list.AddLast(p*perProducer + i)
+					}
+				}()
+				go func() {
+					defer wg.Done()
+					count := 0
+					for range perProducer / 2 {
+						if v, err := list.RemoveFirst(); err == nil {
+							if _, dup := removed.LoadOrStore(v, true); dup {
+								t.Errorf("element %d removed twice", v)
+							}
+							count++
+						}
+					}
+					removedCount <- count
+				}()
+				go func() {
+					defer wg.Done()
+					for range 10 {
+						prev := -1
+						for i := range list.All() {
+							if i != prev+1 {
+								t.Errorf("All() index %d after %d", i, prev)
+							}
+							prev = i
+						}
+						list.Len()
+						list.GetFirst()
+					}
+				}()
+			}
+			wg.Wait()
+			close(removedCount)
+			total := 0
+			for c := range removedCount {
+				total += c
+			}
+			if list.Len()+total != producers*perProducer {
+				t.Errorf("Len() + removed = %d, want %d", list.Len()+total, producers*perProducer)
+			}
+			for v := range list.Values() {
+				if _, ok := removed.Load(v); ok {
+					t.Errorf("element %d is both removed and in the list", v)
+				}
+			}
+		})
+	}
+}
+
+// TestSynchronized_ConcurrentGet runs Get from many goroutines holding only the read lock, run it with -race:
+// Get moves the finger of the DoublyLinkedList and CircularLinkedList, which must not race nor return a wrong element.
+func TestSynchronized_ConcurrentGet(t *testing.T) {
+	const readers, n = 8, 1000
+	for _, lt := range allLinkedListTypes {
+		t.Run(lt.String(), func(t *testing.T) {
+			list := Synchronized(NewLinkedList[int](lt))
+			for i := range n {
+				list.AddLast(i)
+			}
+			var wg sync.WaitGroup
+			for r := range readers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					// every reader walks the list with a different stride, so the fingers keep moving apart
+					for i := 0; i < 4*n; i++ {
+						index := (i * (2*r + 1)) % n
+						if v, err := list.Get(index); v != index || err != nil {
+							t.Errorf("Get(%d) = %v, %v, want %v, nil", index, v, err, index)
+							return
+						}
+					}
+				}()
+			}
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// unrolledNodeCapacity is the number of elements an UnrolledLinkedList node can hold.
+const unrolledNodeCapacity = 64
+
+// UnrolledLinkedList is a doubly linked list of nodes holding up to 64 elements each in an array.
+// Walking the list follows a pointer every 64 elements at most, rather than for every element,
+// and the elements of a node are contiguous in memory: finding an element by index in a large list is several times
+// faster than with a [DoublyLinkedList], and iterating over it is faster too. Adding or removing an element
+// in the middle of a node shifts the following elements of the node.
+//
+// A node that gets full is split in two halves, and a node whose elements fit in half a node along with those
+// of the next node is merged with it, so removing elements does not leave the list with sparse nodes.
+//
+// UnrolledLinkedList has no per-element nodes: the [ImmutableNode] returned by [UnrolledLinkedList.GetHeadNode]
+// and [UnrolledLinkedList.GetTailNode] is a position in the list, only valid until the list is modified.
+type UnrolledLinkedList[T any] struct {
+	head, tail *unrolledNode[T]
+	len        int
+	modCount   int // number of structural modifications, used to detect concurrent modification
+}
+
+type unrolledNode[T any] struct {
+	elements   [unrolledNodeCapacity]T
+	count      int // number of elements in use, from the start of the array
+	next, prev *unrolledNode[T]
+}
+
+func (u *UnrolledLinkedList[T]) AddLast(e T) LinkedList[T] {
+	if u.tail == nil || u.tail.count == unrolledNodeCapacity {
+		u.linkNodeAfter(&unrolledNode[T]{}, u.tail)
+	}
+	u.insertAt(u.tail, u.tail.count, e)
+	return u
+}
+
+func (u *UnrolledLinkedList[T]) AddFirst(e T) LinkedList[T] {
+	if u.head == nil || u.head.count == unrolledNodeCapacity {
+		u.linkNodeAfter(&unrolledNode[T]{}, nil)
+	}
+	u.insertAt(u.head, 0, e)
+	return u
+}
+
+func (u *UnrolledLinkedList[T]) Insert(e T, index int) (bool, error) {
+	switch {
+	case index < 0 || index > u.Len():
+		return false, errIndexOutOfBounds(index, u.Len())
+	case index == u.Len():
+		u.AddLast(e)
+		return true, nil
+	}
+	n, i := u.nodeAt(index)
+	if n.count == unrolledNodeCapacity {
+		u.split(n)
+		if i > n.count {
+			n, i = n.next, i-n.count
+		}
+	}
+	u.insertAt(n, i, e)
+	return true, nil
+}
+
+func (u *UnrolledLinkedList[T]) GetFirst() (T, error) {
+	if u.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return u.head.elements[0], nil
+}
+
+func (u *UnrolledLinkedList[T]) GetLast() (T, error) {
+	if u.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return u.tail.elements[u.tail.count-1], nil
+}
+
+// Get returns the element at the given index, walking the nodes from the nearer end of the list.
+func (u *UnrolledLinkedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= u.Len() {
+		var zero T
+		return zero, errIndexOutOfBounds(index, u.Len())
+	}
+	n, i := u.nodeAt(index)
+	return n.elements[i], nil
+}
+
+func (u *UnrolledLinkedList[T]) GetHeadNode() (ImmutableNode[T], error) {
+	if u == nil || u.IsEmpty() {
+		return nil, errNoSuchElement()
+	}
+	return unrolledPosition[T]{u.head, 0}, nil
+}
+
+func (u *UnrolledLinkedList[T]) GetTailNode() (ImmutableNode[T], error) {
+	if u == nil || u.IsEmpty() {
+		return nil, errNoSuchElement()
+	}
+	return unrolledPosition[T]{u.tail, u.tail.count - 1}, nil
+}
+
+func (u *UnrolledLinkedList[T]) Len() int {
+	return u.len
+}
+
+func (u *UnrolledLinkedList[T]) IsEmpty() bool {
+	return u.Len() == 0
+}
+
+func (u *UnrolledLinkedList[T]) RemoveFirst() (T, error) {
+	if u.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return u.removeAt(u.head, 0), nil
+}
+
+func (u *UnrolledLinkedList[T]) RemoveLast() (T, error) {
+	if u.IsEmpty() {
+		var zero T
+		return zero, errNoSuchElement()
+	}
+	return u.removeAt(u.tail, u.tail.count-1), nil
+}
+
+func (u *UnrolledLinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= u.Len() {
+		var zero T
+		return zero, errIndexOutOfBounds(index, u.Len())
+	}
+	n, i := u.nodeAt(index)
+	return u.removeAt(n, i), nil
+}
+
+func (u *UnrolledLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		expectedModCount := u.modCount
+		index := 0
+		for n := u.head; n != nil; n = n.next {
+			for i := 0; i < n.count; i, index = i+1, index+1 {
+				if !yield(index, n.elements[i]) {
+					return
+				}
+				u.checkModCount(expectedModCount)
+			}
+		}
+	}
+}
+
+func (u *UnrolledLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := u.modCount
+		for n := u.head; n != nil; n = n.next {
+			for i := 0; i < n.count; i++ {
+				if !yield(n.elements[i]) {
+					return
+				}
+				u.checkModCount(expectedModCount)
+			}
+		}
+	}
+}
+
+func (u *UnrolledLinkedList[T]) ReverseAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		expectedModCount := u.modCount
+		index := u.Len() - 1
+		for n := u.tail; n != nil; n = n.prev {
+			for i := n.count - 1; i >= 0; i, index = i-1, index-1 {
+				if !yield(index, n.elements[i]) {
+					return
+				}
+				u.checkModCount(expectedModCount)
+			}
+		}
+	}
+}
+
+func (u *UnrolledLinkedList[T]) ToSlice() []T {
+	if u == nil {
+		return nil
+	}
+	slice := make([]T, 0, u.Len())
+	for n := u.head; n != nil; n = n.next {
+		slice = append(slice, n.elements[:n.count]...)
+	}
+	return slice
+}
+
+func (u *UnrolledLinkedList[T]) String() string {
+	if u == nil {
+		return "nil"
+	}
+	var sb strings.Builder
+	for i, v := range u.All() {
+		sb.WriteString(fmt.Sprintf("%v", v))
+		if i+1 < u.Len() {
+			sb.WriteString(" <=> ")
+		}
+	}
+	return sb.String()
+}
+
+// nodeAt returns the node holding the element at the given index, which must be valid,
+// and the position of the element in the node. The nodes are walked from the nearer end of the list.
+func (u *UnrolledLinkedList[T]) nodeAt(index int) (*unrolledNode[T], int) {
+	if index < u.len/2 {
+		n := u.head
+		for index >= n.count {
+			index -= n.count
+			n = n.next
+		}
+		return n, index
+	}
+	n, fromEnd := u.tail, u.len-1-index
+	for fromEnd >= n.count {
+		fromEnd -= n.count
+		n = n.prev
+	}
+	return n, n.count - 1 - fromEnd
+}
+
+// insertAt inserts e at the position i of the node n, which must not be full.
+func (u *UnrolledLinkedList[T]) insertAt(n *unrolledNode[T], i int, e T) {
+	copy(n.elements[i+1:n.count+1], n.elements[i:n.count])
+	n.elements[i] = e
+	n.count++
+	u.len++
+	u.modCount++
+}
+
+// removeAt removes the element at the position i of the node n and returns it,
+// then unlinks the node if it is empty, or merges the next node into it if both fit in half a node.
+func (u *UnrolledLinkedList[T]) removeAt(n *unrolledNode[T], i int) T {
+	value := n.elements[i]
+	copy(n.elements[i:n.count-1], n.elements[i+1:n.count])
+	var zero T
+	n.elements[n.count-1] = zero // do not retain the removed element
+	n.count--
+	u.len--
+	u.modCount++
+	switch next := n.next; {
+	case n.count == 0:
+		u.unlinkNode(n)
+	case next != nil && n.count+next.count <= unrolledNodeCapacity/2:
+		copy(n.elements[n.count:], next.elements[:next.count])
+		n.count += next.count
+		u.unlinkNode(next)
+	}
+	return value
+}
+
+// split moves the second half of the elements of the full node n to a new node linked after it.
+func (u *UnrolledLinkedList[T]) split(n *unrolledNode[T]) {
+	half := n.count / 2
+	m := &unrolledNode[T]{count: n.count - half}
+	copy(m.elements[:], n.elements[half:n.count])
+	clear(n.elements[half:n.count])
+	n.count = half
+	u.linkNodeAfter(m, n)
+}
+
+// linkNodeAfter links the node n right after pred, or as the head if pred is nil.
+// It does not change the length, n being empty or holding elements already counted.
+func (u *UnrolledLinkedList[T]) linkNodeAfter(n, pred *unrolledNode[T]) {
+	n.prev = pred
+	if pred == nil {
+		n.next, u.head = u.head, n
+	} else {
+		n.next, pred.next = pred.next, n
+	}
+	if n.next == nil {
+		u.tail = n
+	} else {
+		n.next.prev = n
+	}
+}
+
+// unlinkNode unlinks the node n, whose elements were removed or moved to another node.
+func (u *UnrolledLinkedList[T]) unlinkNode(n *unrolledNode[T]) {
+	if n.prev == nil {
+		u.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		u.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.next, n.prev = nil, nil
+}
+
+// checkModCount panics with an [ErrConcurrentModification] error
+// if the list was structurally modified since expectedModCount was read.
+func (u *UnrolledLinkedList[T]) checkModCount(expectedModCount int) {
+	if u.modCount != expectedModCount {
+		panic(errConcurrentModification())
+	}
+}
+
+func (u *UnrolledLinkedList[T]) listType() linkedListType {
+	return Unrolled
+}
+
+// unrolledPosition is the [ImmutableNode] of an [UnrolledLinkedList]: the position i in the node n.
+type unrolledPosition[T any] struct {
+	n *unrolledNode[T]
+	i int
+}
+
+func (p unrolledPosition[T]) Value() T {
+	return p.n.elements[p.i]
+}
+
+func (p unrolledPosition[T]) Next() ImmutableNode[T] {
+	switch {
+	case p.i+1 < p.n.count:
+		return unrolledPosition[T]{p.n, p.i + 1}
+	case p.n.next != nil:
+		return unrolledPosition[T]{p.n.next, 0}
+	default:
+		return nil
+	}
+}
+
+func (p unrolledPosition[T]) Prev() ImmutableNode[T] {
+	switch {
+	case p.i > 0:
+		return unrolledPosition[T]{p.n, p.i - 1}
+	case p.n.prev != nil:
+		return unrolledPosition[T]{p.n.prev, p.n.prev.count - 1}
+	default:
+		return nil
+	}
+}
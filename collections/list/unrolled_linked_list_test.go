@@ -0,0 +1,123 @@
+package list
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkUnrolledNodes checks the elements of u, and that its nodes are linked both ways, not empty and within capacity.
+func checkUnrolledNodes[T comparable](t *testing.T, u *UnrolledLinkedList[T], want []T) {
+	t.Helper()
+	var got []T
+	var prev *unrolledNode[T]
+	for n := u.head; n != nil; prev, n = n, n.next {
+		if n.prev != prev {
+			t.Fatalf("node prev link is broken after %v elements", len(got))
+		}
+		if n.count <= 0 || n.count > unrolledNodeCapacity {
+			t.Fatalf("node count = %v, want 1 to %v", n.count, unrolledNodeCapacity)
+		}
+		got = append(got, n.elements[:n.count]...)
+	}
+	if u.tail != prev || u.len != len(want) || !slices.Equal(got, want) {
+		t.Fatalf("nodes hold %v (len %v), want %v", got, u.len, want)
+	}
+}
+
+func TestUnrolledLinkedList_SplitAndMerge(t *testing.T) {
+	u := &UnrolledLinkedList[int]{}
+	var want []int
+	r := rand.New(rand.NewPCG(3, 4))
+	// inserting at random indexes splits full nodes
+	for i := range 1000 {
+		index := r.IntN(len(want) + 1)
+		u.Insert(i, index)
+		want = slices.Insert(want, index, i)
+	}
+	checkUnrolledNodes(t, u, want)
+	checkEnds[int](t, u, want)
+	// removing at random indexes merges the nodes that get sparse
+	for len(want) > 10 {
+		index := r.IntN(len(want))
+		if v, _ := u.RemoveAt(index); v != want[index] {
+			t.Fatalf("RemoveAt(%v) = %v, want %v", index, v, want[index])
+		}
+		want = slices.Delete(want, index, index+1)
+	}
+	checkUnrolledNodes(t, u, want)
+	if u.head != u.tail {
+		t.Errorf("%v elements are held by several nodes, want a single one", len(want))
+	}
+}
+
+func TestUnrolledLinkedList_Ends(t *testing.T) {
+	u := NewLinkedList[int](Unrolled).(*UnrolledLinkedList[int])
+	var want []int
+	for i := range 200 {
+		u.AddFirst(-i)
+		u.AddLast(i)
+		want = append([]int{-i}, append(want, i)...)
+	}
+	checkUnrolledNodes(t, u, want)
+	for i, node := 0, ImmutableNode[int](nil); i < len(want); i++ {
+		if i == 0 {
+			node, _ = u.GetHeadNode()
+		} else {
+			node = node.Next()
+		}
+		if node.Value() != want[i] || (i > 0 && node.Prev().Value() != want[i-1]) {
+			t.Fatalf("node %v = %v, want %v", i, node.Value(), want[i])
+		}
+	}
+	for len(want) > 0 {
+		first, _ := u.RemoveFirst()
+		last, _ := u.RemoveLast()
+		if first != want[0] || last != want[len(want)-1] {
+			t.Fatalf("RemoveFirst(), RemoveLast() = %v, %v, want %v, %v", first, last, want[0], want[len(want)-1])
+		}
+		want = want[1 : len(want)-1]
+	}
+	checkUnrolledNodes(t, u, []int{})
+	checkEnds[int](t, u, []int{})
+	if lt := typeOf[int](NewLinkedListFromSlice(Unrolled, []int{1})); lt != Unrolled {
+		t.Errorf("typeOf() = %v, want %v", lt, Unrolled)
+	}
+}
+
+func unrolledBenchmarkLists() map[string]LinkedList[int] {
+	d, u := &DoublyLinkedList[int]{}, &UnrolledLinkedList[int]{}
+	for i := range benchmarkListLen {
+		d.AddLast(i)
+		u.AddLast(i)
+	}
+	return map[string]LinkedList[int]{DoublyLinked.String(): d, Unrolled.String(): u}
+}
+
+// BenchmarkUnrolledLinkedList_Iterate measures iterating over the 1M elements of a list with Values.
+func BenchmarkUnrolledLinkedList_Iterate(b *testing.B) {
+	for name, l := range unrolledBenchmarkLists() {
+		b.Run(name, func(b *testing.B) {
+			for b.Loop() {
+				sum := 0
+				for v := range l.Values() {
+					sum += v
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkUnrolledLinkedList_Get measures Get at random indexes of a 1M element list,
+// where the finger of the DoublyLinkedList does not help.
+func BenchmarkUnrolledLinkedList_Get(b *testing.B) {
+	for name, l := range unrolledBenchmarkLists() {
+		b.Run(fmt.Sprintf("%v/random", name), func(b *testing.B) {
+			r := rand.New(rand.NewPCG(1, 2))
+			for b.Loop() {
+				l.Get(r.IntN(benchmarkListLen))
+			}
+		})
+	}
+}